@@ -0,0 +1,106 @@
+package orderbook
+
+import "sort"
+
+// bookSide is a price-sorted slice of levels, searched and updated with
+// binary search. Bids are kept in descending price order, asks ascending.
+type bookSide struct {
+	levels     []OrderBookLevel
+	descending bool
+}
+
+func newBookSide(descending bool) *bookSide {
+	return &bookSide{descending: descending}
+}
+
+// less reports whether price a should sort before price b for this side
+func (s *bookSide) less(a, b float64) bool {
+	if s.descending {
+		return a > b
+	}
+	return a < b
+}
+
+// upsert sets the quantity at price, inserting a new level if needed, or
+// removes the level entirely when quantity is zero
+func (s *bookSide) upsert(price, quantity float64) {
+	i := sort.Search(len(s.levels), func(i int) bool {
+		return !s.less(s.levels[i].Price, price)
+	})
+
+	found := i < len(s.levels) && s.levels[i].Price == price
+
+	if quantity == 0 {
+		if found {
+			s.levels = append(s.levels[:i], s.levels[i+1:]...)
+		}
+		return
+	}
+
+	if found {
+		s.levels[i].Quantity = quantity
+		return
+	}
+
+	s.levels = append(s.levels, OrderBookLevel{})
+	copy(s.levels[i+1:], s.levels[i:])
+	s.levels[i] = OrderBookLevel{Price: price, Quantity: quantity}
+}
+
+// snapshot returns a copy of the current levels
+func (s *bookSide) snapshot() []OrderBookLevel {
+	out := make([]OrderBookLevel, len(s.levels))
+	copy(out, s.levels)
+	return out
+}
+
+// book is the mutable, price-sorted local copy of a symbol's order book
+type book struct {
+	symbol       string
+	lastUpdateID int64
+	bids         *bookSide
+	asks         *bookSide
+}
+
+func newBook(symbol string) *book {
+	return &book{
+		symbol: symbol,
+		bids:   newBookSide(true),
+		asks:   newBookSide(false),
+	}
+}
+
+// loadSnapshot replaces the book's contents with a REST snapshot
+func (b *book) loadSnapshot(snapshot OrderBook) {
+	b.lastUpdateID = snapshot.LastUpdateID
+	b.bids = newBookSide(true)
+	b.asks = newBookSide(false)
+	for _, level := range snapshot.Bids {
+		b.bids.upsert(level.Price, level.Quantity)
+	}
+	for _, level := range snapshot.Asks {
+		b.asks.upsert(level.Price, level.Quantity)
+	}
+}
+
+// applyDiff merges a diff-depth event's bid/ask levels into the book
+func (b *book) applyDiff(event diffDepthEvent) {
+	for _, level := range event.Bids {
+		b.bids.upsert(level.Price, level.Quantity)
+	}
+	for _, level := range event.Asks {
+		b.asks.upsert(level.Price, level.Quantity)
+	}
+	b.lastUpdateID = event.FinalUpdateID
+}
+
+// toOrderBook renders the current state as an OrderBook
+func (b *book) toOrderBook(ts int64) OrderBook {
+	return OrderBook{
+		Symbol:       b.symbol,
+		LastUpdateID: b.lastUpdateID,
+		Bids:         b.bids.snapshot(),
+		Asks:         b.asks.snapshot(),
+		Timestamp:    unixMillisToTime(ts),
+	}
+}
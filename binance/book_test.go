@@ -0,0 +1,73 @@
+package orderbook
+
+import "testing"
+
+func TestBookSideUpsertInsertsInPriceOrder(t *testing.T) {
+	bids := newBookSide(true)
+	bids.upsert(100.0, 1)
+	bids.upsert(102.0, 2)
+	bids.upsert(101.0, 3)
+
+	got := bids.snapshot()
+	want := []float64{102.0, 101.0, 100.0}
+	if len(got) != len(want) {
+		t.Fatalf("got %d levels, want %d", len(got), len(want))
+	}
+	for i, price := range want {
+		if got[i].Price != price {
+			t.Errorf("level %d: got price %v, want %v", i, got[i].Price, price)
+		}
+	}
+}
+
+func TestBookSideUpsertRemovesZeroQuantityLevel(t *testing.T) {
+	asks := newBookSide(false)
+	asks.upsert(10.0, 5)
+	asks.upsert(11.0, 5)
+
+	asks.upsert(10.0, 0)
+
+	got := asks.snapshot()
+	if len(got) != 1 || got[0].Price != 11.0 {
+		t.Fatalf("expected only the 11.0 level to remain, got %+v", got)
+	}
+}
+
+func TestBookSideUpsertUpdatesExistingLevel(t *testing.T) {
+	bids := newBookSide(true)
+	bids.upsert(50.0, 1)
+	bids.upsert(50.0, 4)
+
+	got := bids.snapshot()
+	if len(got) != 1 || got[0].Quantity != 4 {
+		t.Fatalf("expected the level's quantity to be updated in place, got %+v", got)
+	}
+}
+
+func TestBookApplyDiffTracksLastUpdateID(t *testing.T) {
+	b := newBook("BTCUSDT")
+	b.loadSnapshot(OrderBook{
+		Symbol:       "BTCUSDT",
+		LastUpdateID: 100,
+		Bids:         []OrderBookLevel{{Price: 10, Quantity: 1}},
+		Asks:         []OrderBookLevel{{Price: 11, Quantity: 1}},
+	})
+
+	b.applyDiff(diffDepthEvent{
+		FirstUpdateID: 101,
+		FinalUpdateID: 105,
+		Bids:          []OrderBookLevel{{Price: 10, Quantity: 0}},
+		Asks:          []OrderBookLevel{{Price: 12, Quantity: 2}},
+	})
+
+	ob := b.toOrderBook(0)
+	if ob.LastUpdateID != 105 {
+		t.Errorf("got LastUpdateID %d, want 105", ob.LastUpdateID)
+	}
+	if len(ob.Bids) != 0 {
+		t.Errorf("expected the zero-quantity bid to be removed, got %+v", ob.Bids)
+	}
+	if len(ob.Asks) != 2 {
+		t.Errorf("expected the new ask level to be added, got %+v", ob.Asks)
+	}
+}
@@ -0,0 +1,291 @@
+// Package orderbook maintains a correctly synchronized local copy of a
+// Binance order book by combining a REST snapshot with the buffered
+// WebSocket diff-depth stream, per Binance's documented synchronization
+// procedure.
+package orderbook
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	pkgorderbook "github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+const (
+	defaultWsURL   = "wss://stream.binance.com:9443/ws"
+	defaultRestURL = "https://api.binance.com/api/v3/depth"
+)
+
+// OrderBookLevel is a single price/quantity level in an order book
+type OrderBookLevel struct {
+	Price    float64
+	Quantity float64
+}
+
+// OrderBook is a point-in-time view of a symbol's order book
+type OrderBook struct {
+	Symbol       string
+	LastUpdateID int64
+	Bids         []OrderBookLevel
+	Asks         []OrderBookLevel
+	Timestamp    time.Time
+}
+
+// OrderBookEvent is delivered to subscribers on every applied diff, or
+// carries Error when a synchronization attempt has failed; the book is then
+// resynchronized and later events carry the fresh book again
+type OrderBookEvent struct {
+	OrderBook OrderBook
+	Symbol    string
+	Error     error
+}
+
+// BinanceClient maintains synchronized order books for subscribed symbols
+// by buffering the diff-depth WebSocket stream against a REST snapshot
+type BinanceClient struct {
+	wsURL      string
+	restURL    string
+	httpClient *http.Client
+
+	mu                    sync.RWMutex
+	subscribers           map[string][]chan OrderBookEvent
+	syncs                 map[string]*bookSync
+	tickerSubscribers     map[string][]chan BookTicker
+	tickers               map[string]*tickerSync
+	miniTickerSubscribers []chan []pkgorderbook.MiniTicker
+	miniTickers           *miniTickerStream
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewBinanceClient creates a BinanceClient ready to subscribe to symbols
+func NewBinanceClient() *BinanceClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BinanceClient{
+		wsURL:             defaultWsURL,
+		restURL:           defaultRestURL,
+		httpClient:        &http.Client{Timeout: 10 * time.Second},
+		subscribers:       make(map[string][]chan OrderBookEvent),
+		syncs:             make(map[string]*bookSync),
+		tickerSubscribers: make(map[string][]chan BookTicker),
+		tickers:           make(map[string]*tickerSync),
+		ctx:               ctx,
+		cancel:            cancel,
+	}
+}
+
+// Close stops every in-flight synchronization and closes all subscriber channels
+func (c *BinanceClient) Close() error {
+	c.cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for symbol, subs := range c.subscribers {
+		for _, ch := range subs {
+			close(ch)
+		}
+		delete(c.subscribers, symbol)
+		delete(c.syncs, symbol)
+	}
+
+	for symbol, subs := range c.tickerSubscribers {
+		for _, ch := range subs {
+			close(ch)
+		}
+		delete(c.tickerSubscribers, symbol)
+		delete(c.tickers, symbol)
+	}
+
+	for _, ch := range c.miniTickerSubscribers {
+		close(ch)
+	}
+	c.miniTickerSubscribers = nil
+	c.miniTickers = nil
+	return nil
+}
+
+// Subscribe starts (or joins) synchronization for symbol and returns a
+// channel of OrderBookEvent reflecting the full book after each applied diff
+func (c *BinanceClient) Subscribe(symbol string) (<-chan OrderBookEvent, error) {
+	symbol = normalizeSymbol(symbol)
+
+	c.mu.Lock()
+	ch := make(chan OrderBookEvent, 100)
+	c.subscribers[symbol] = append(c.subscribers[symbol], ch)
+	bs, exists := c.syncs[symbol]
+	if !exists {
+		bs = newBookSync(c, symbol)
+		c.syncs[symbol] = bs
+	}
+	c.mu.Unlock()
+
+	if !exists {
+		go bs.run(c.ctx)
+	}
+
+	return ch, nil
+}
+
+// Unsubscribe removes ch from symbol's subscribers, closing it. Once the
+// last subscriber for a symbol leaves, its synchronization loop is stopped.
+func (c *BinanceClient) Unsubscribe(symbol string, ch <-chan OrderBookEvent) {
+	symbol = normalizeSymbol(symbol)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subs := c.subscribers[symbol]
+	for i, sub := range subs {
+		if sub == ch {
+			close(sub)
+			c.subscribers[symbol] = append(subs[:i], subs[i+1:]...)
+			break
+		}
+	}
+
+	if len(c.subscribers[symbol]) == 0 {
+		if bs, ok := c.syncs[symbol]; ok {
+			bs.stop()
+			delete(c.syncs, symbol)
+		}
+		delete(c.subscribers, symbol)
+	}
+}
+
+// publish delivers event to every current subscriber of symbol
+func (c *BinanceClient) publish(symbol string, event OrderBookEvent) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, ch := range c.subscribers[symbol] {
+		select {
+		case ch <- event:
+		default:
+			// Slow subscriber; drop rather than block the sync loop
+		}
+	}
+}
+
+// SubscribeBookTicker starts (or joins) a best bid/offer stream for symbol
+// and returns a channel of BookTicker updates. It runs independently of
+// Subscribe's full-depth synchronization, so callers only paying attention
+// to the top of book avoid the cost of maintaining the full order book.
+func (c *BinanceClient) SubscribeBookTicker(symbol string) (<-chan BookTicker, error) {
+	symbol = normalizeSymbol(symbol)
+
+	c.mu.Lock()
+	ch := make(chan BookTicker, 100)
+	c.tickerSubscribers[symbol] = append(c.tickerSubscribers[symbol], ch)
+	ts, exists := c.tickers[symbol]
+	if !exists {
+		ts = newTickerSync(c, symbol)
+		c.tickers[symbol] = ts
+	}
+	c.mu.Unlock()
+
+	if !exists {
+		go ts.run(c.ctx)
+	}
+
+	return ch, nil
+}
+
+// UnsubscribeBookTicker removes ch from symbol's BookTicker subscribers,
+// closing it. Once the last subscriber for a symbol leaves, its stream is stopped.
+func (c *BinanceClient) UnsubscribeBookTicker(symbol string, ch <-chan BookTicker) {
+	symbol = normalizeSymbol(symbol)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subs := c.tickerSubscribers[symbol]
+	for i, sub := range subs {
+		if sub == ch {
+			close(sub)
+			c.tickerSubscribers[symbol] = append(subs[:i], subs[i+1:]...)
+			break
+		}
+	}
+
+	if len(c.tickerSubscribers[symbol]) == 0 {
+		if ts, ok := c.tickers[symbol]; ok {
+			ts.stop()
+			delete(c.tickers, symbol)
+		}
+		delete(c.tickerSubscribers, symbol)
+	}
+}
+
+// publishTicker delivers bt to every current BookTicker subscriber of symbol
+func (c *BinanceClient) publishTicker(symbol string, bt BookTicker) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, ch := range c.tickerSubscribers[symbol] {
+		select {
+		case ch <- bt:
+		default:
+			// Slow subscriber; drop rather than block the stream loop
+		}
+	}
+}
+
+// SubscribeAllMiniTickers starts (or joins) Binance's all-market
+// !miniTicker@arr stream and returns a channel of MiniTicker batches, one
+// batch per message, covering every actively traded symbol. It runs
+// independently of any per-symbol subscription.
+func (c *BinanceClient) SubscribeAllMiniTickers() (<-chan []pkgorderbook.MiniTicker, error) {
+	c.mu.Lock()
+	ch := make(chan []pkgorderbook.MiniTicker, 100)
+	c.miniTickerSubscribers = append(c.miniTickerSubscribers, ch)
+	if c.miniTickers == nil {
+		c.miniTickers = newMiniTickerStream(c)
+		go c.miniTickers.run(c.ctx)
+	}
+	c.mu.Unlock()
+
+	return ch, nil
+}
+
+// UnsubscribeAllMiniTickers removes ch from the mini-ticker subscribers,
+// closing it. Once the last subscriber leaves, the stream is stopped.
+func (c *BinanceClient) UnsubscribeAllMiniTickers(ch <-chan []pkgorderbook.MiniTicker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, sub := range c.miniTickerSubscribers {
+		if sub == ch {
+			close(sub)
+			c.miniTickerSubscribers = append(c.miniTickerSubscribers[:i], c.miniTickerSubscribers[i+1:]...)
+			break
+		}
+	}
+
+	if len(c.miniTickerSubscribers) == 0 && c.miniTickers != nil {
+		c.miniTickers.stop()
+		c.miniTickers = nil
+	}
+}
+
+// publishMiniTickers delivers tickers to every current mini-ticker subscriber
+func (c *BinanceClient) publishMiniTickers(tickers []pkgorderbook.MiniTicker) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, ch := range c.miniTickerSubscribers {
+		select {
+		case ch <- tickers:
+		default:
+			// Slow subscriber; drop rather than block the stream loop
+		}
+	}
+}
+
+func normalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.ReplaceAll(symbol, "/", ""))
+}
@@ -0,0 +1,170 @@
+package orderbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	pkgorderbook "github.com/VladKochetov007/lob_view/pkg/orderbook"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook/reconnect"
+	"github.com/gorilla/websocket"
+)
+
+const miniTickerStreamURL = "wss://stream.binance.com:9443/ws/!miniTicker@arr"
+
+// wireMiniTicker mirrors one element of Binance's !miniTicker@arr payload
+type wireMiniTicker struct {
+	EventTime   int64  `json:"E"`
+	Symbol      string `json:"s"`
+	Close       string `json:"c"`
+	Open        string `json:"o"`
+	High        string `json:"h"`
+	Low         string `json:"l"`
+	Volume      string `json:"v"`
+	QuoteVolume string `json:"q"`
+}
+
+// miniTickerStream keeps the all-market !miniTicker@arr stream alive,
+// reconnecting with exponential backoff, and fans each batch out to every
+// subscriber registered on client via client.publishMiniTickers.
+type miniTickerStream struct {
+	client *BinanceClient
+	done   chan struct{}
+}
+
+func newMiniTickerStream(client *BinanceClient) *miniTickerStream {
+	return &miniTickerStream{
+		client: client,
+		done:   make(chan struct{}),
+	}
+}
+
+func (s *miniTickerStream) stop() {
+	close(s.done)
+}
+
+func (s *miniTickerStream) run(ctx context.Context) {
+	backoff := reconnect.NewBackoff(reconnect.DefaultBackoffConfig())
+	breaker := reconnect.NewCircuitBreaker(reconnect.DefaultCircuitBreakerConfig())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		default:
+		}
+
+		if breaker.Open() {
+			if !s.sleep(ctx, backoff.Next()) {
+				return
+			}
+			continue
+		}
+
+		if err := s.streamOnce(ctx); err != nil {
+			breaker.RecordError()
+			if !s.sleep(ctx, backoff.Next()) {
+				return
+			}
+			continue
+		}
+
+		breaker.RecordSuccess()
+		backoff.Reset()
+	}
+}
+
+func (s *miniTickerStream) sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	case <-s.done:
+		return false
+	}
+}
+
+func (s *miniTickerStream) streamOnce(ctx context.Context) error {
+	conn, _, err := websocket.DefaultDialer.Dial(miniTickerStreamURL, nil)
+	if err != nil {
+		return fmt.Errorf("websocket connection error: %w", err)
+	}
+	defer conn.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.done:
+			return nil
+		default:
+		}
+
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("read: %w", err)
+		}
+
+		tickers, err := parseMiniTickers(msg)
+		if err != nil {
+			continue
+		}
+
+		s.client.publishMiniTickers(tickers)
+	}
+}
+
+// parseMiniTickers converts a !miniTicker@arr payload into pkgorderbook.MiniTicker values,
+// skipping any entry whose numeric fields fail to parse
+func parseMiniTickers(msg []byte) ([]pkgorderbook.MiniTicker, error) {
+	var wire []wireMiniTicker
+	if err := json.Unmarshal(msg, &wire); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal miniTicker@arr: %w", err)
+	}
+
+	tickers := make([]pkgorderbook.MiniTicker, 0, len(wire))
+	for _, w := range wire {
+		open, err := strconv.ParseFloat(w.Open, 64)
+		if err != nil {
+			continue
+		}
+		high, err := strconv.ParseFloat(w.High, 64)
+		if err != nil {
+			continue
+		}
+		low, err := strconv.ParseFloat(w.Low, 64)
+		if err != nil {
+			continue
+		}
+		closePrice, err := strconv.ParseFloat(w.Close, 64)
+		if err != nil {
+			continue
+		}
+		volume, err := strconv.ParseFloat(w.Volume, 64)
+		if err != nil {
+			continue
+		}
+		quoteVolume, err := strconv.ParseFloat(w.QuoteVolume, 64)
+		if err != nil {
+			continue
+		}
+
+		tickers = append(tickers, pkgorderbook.MiniTicker{
+			Symbol:      w.Symbol,
+			Open:        open,
+			High:        high,
+			Low:         low,
+			Close:       closePrice,
+			Volume:      volume,
+			QuoteVolume: quoteVolume,
+			EventTime:   unixMillisToTime(w.EventTime),
+		})
+	}
+
+	return tickers, nil
+}
@@ -0,0 +1,273 @@
+package orderbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook/reconnect"
+	"github.com/gorilla/websocket"
+)
+
+// errSyncGap is returned internally when an applied event's continuity check
+// fails and the book must be resynchronized from a fresh REST snapshot
+var errSyncGap = errors.New("order book update gap detected")
+
+// bookSync drives the snapshot + buffered-diff synchronization procedure for
+// a single symbol, per Binance's documented diff-depth algorithm:
+//
+//  1. open the diff-depth WebSocket stream and buffer every event
+//  2. fetch a REST snapshot with lastUpdateId = U0
+//  3. discard buffered events whose u < U0
+//  4. the first applied event must satisfy U <= U0+1 <= u, else refetch
+//  5. apply that event and all subsequent events, requiring continuity
+//     between consecutive events; any gap triggers a resync from step 2
+type bookSync struct {
+	client  *BinanceClient
+	symbol  string
+	book    *book
+	done    chan struct{}
+	backoff *reconnect.Backoff
+	breaker *reconnect.CircuitBreaker
+}
+
+func newBookSync(client *BinanceClient, symbol string) *bookSync {
+	return &bookSync{
+		client:  client,
+		symbol:  symbol,
+		book:    newBook(symbol),
+		done:    make(chan struct{}),
+		backoff: reconnect.NewBackoff(reconnect.DefaultBackoffConfig()),
+		breaker: reconnect.NewCircuitBreaker(reconnect.DefaultCircuitBreakerConfig()),
+	}
+}
+
+func (s *bookSync) stop() {
+	close(s.done)
+}
+
+// run keeps the book synchronized until ctx is cancelled or stop is called.
+// Reconnects after a dropped connection or sync gap back off exponentially
+// with jitter; once the breaker trips from too many failures, reconnects are
+// paused for its cooldown period and ErrCircuitOpen is surfaced instead.
+func (s *bookSync) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.done:
+			return
+		default:
+		}
+
+		if s.breaker.Open() {
+			s.client.publish(s.symbol, OrderBookEvent{Symbol: s.symbol, Error: reconnect.ErrCircuitOpen})
+			if !s.sleep(ctx, s.backoff.Next()) {
+				return
+			}
+			continue
+		}
+
+		if err := s.syncOnce(ctx); err != nil {
+			s.breaker.RecordError()
+			s.client.publish(s.symbol, OrderBookEvent{Symbol: s.symbol, Error: err})
+			if !s.sleep(ctx, s.backoff.Next()) {
+				return
+			}
+			continue
+		}
+
+		s.breaker.RecordSuccess()
+		s.backoff.Reset()
+	}
+}
+
+// sleep waits for d or until ctx/done fire, reporting whether it completed
+// the full wait (false means the caller should stop)
+func (s *bookSync) sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	case <-s.done:
+		return false
+	}
+}
+
+// syncOnce performs one full connect -> snapshot -> stream cycle, returning
+// when the stream ends, the context is cancelled, or a gap is detected
+func (s *bookSync) syncOnce(ctx context.Context) error {
+	conn, err := s.dial()
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	events := make(chan diffDepthEvent, 1000)
+	readErrs := make(chan error, 1)
+	go readDiffEvents(conn, events, readErrs)
+
+	snapshot, err := s.fetchSnapshot()
+	if err != nil {
+		return fmt.Errorf("fetch snapshot: %w", err)
+	}
+
+	first, buffered, err := waitForSyncPoint(ctx, events, readErrs, snapshot.LastUpdateID)
+	if err != nil {
+		return err
+	}
+
+	s.book.loadSnapshot(snapshot)
+	s.book.applyDiff(first)
+	s.client.publish(s.symbol, OrderBookEvent{Symbol: s.symbol, OrderBook: s.book.toOrderBook(first.EventTime)})
+	lastAppliedU := first.FinalUpdateID
+
+	for _, event := range buffered {
+		if !continuous(event, lastAppliedU) {
+			return errSyncGap
+		}
+		s.book.applyDiff(event)
+		s.client.publish(s.symbol, OrderBookEvent{Symbol: s.symbol, OrderBook: s.book.toOrderBook(event.EventTime)})
+		lastAppliedU = event.FinalUpdateID
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.done:
+			return nil
+		case err := <-readErrs:
+			return fmt.Errorf("read: %w", err)
+		case event := <-events:
+			if !continuous(event, lastAppliedU) {
+				return errSyncGap
+			}
+			s.book.applyDiff(event)
+			s.client.publish(s.symbol, OrderBookEvent{Symbol: s.symbol, OrderBook: s.book.toOrderBook(event.EventTime)})
+			lastAppliedU = event.FinalUpdateID
+		}
+	}
+}
+
+// continuous reports whether event follows directly from lastAppliedU. When
+// the stream carries pu (the futures diff-depth stream does), it must equal
+// lastAppliedU exactly; otherwise U must pick up where the previous u left off.
+func continuous(event diffDepthEvent, lastAppliedU int64) bool {
+	if event.PrevFinalUpdateID != 0 {
+		return event.PrevFinalUpdateID == lastAppliedU
+	}
+	return event.FirstUpdateID == lastAppliedU+1
+}
+
+// waitForSyncPoint drains buffered events older than the snapshot and
+// returns the first event that straddles it (U <= lastUpdateID+1 <= u)
+// along with any events buffered after it, ready to be applied in order.
+func waitForSyncPoint(ctx context.Context, events <-chan diffDepthEvent, readErrs <-chan error, lastUpdateID int64) (diffDepthEvent, []diffDepthEvent, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return diffDepthEvent{}, nil, ctx.Err()
+		case err := <-readErrs:
+			return diffDepthEvent{}, nil, fmt.Errorf("read: %w", err)
+		case event := <-events:
+			if event.FinalUpdateID < lastUpdateID {
+				continue // stale, predates the snapshot
+			}
+			if event.FirstUpdateID > lastUpdateID+1 || event.FinalUpdateID < lastUpdateID+1 {
+				// The first kept event does not straddle the snapshot
+				return diffDepthEvent{}, nil, errSyncGap
+			}
+
+			buffered := drainBuffered(events)
+			return event, buffered, nil
+		}
+	}
+}
+
+// drainBuffered non-blockingly collects any events already queued
+func drainBuffered(events <-chan diffDepthEvent) []diffDepthEvent {
+	var buffered []diffDepthEvent
+	for {
+		select {
+		case event := <-events:
+			buffered = append(buffered, event)
+		default:
+			return buffered
+		}
+	}
+}
+
+// readDiffEvents reads and parses WebSocket frames until the connection fails
+func readDiffEvents(conn *websocket.Conn, events chan<- diffDepthEvent, errs chan<- error) {
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		event, err := parseDiffDepthEvent(msg)
+		if err != nil {
+			slog.Warn("failed to parse depth update", "error", err)
+			continue
+		}
+		events <- event
+	}
+}
+
+// dial opens the diff-depth WebSocket stream for the symbol
+func (s *bookSync) dial() (*websocket.Conn, error) {
+	u := url.URL{
+		Scheme: "wss",
+		Host:   "stream.binance.com:9443",
+		Path:   "/ws/" + strings.ToLower(s.symbol) + "@depth@100ms",
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("websocket connection error: %w", err)
+	}
+	return conn, nil
+}
+
+// fetchSnapshot retrieves the REST order book snapshot used as the sync anchor
+func (s *bookSync) fetchSnapshot() (OrderBook, error) {
+	snapshotURL := fmt.Sprintf("%s?symbol=%s&limit=1000", s.client.restURL, s.symbol)
+	resp, err := s.client.httpClient.Get(snapshotURL)
+	if err != nil {
+		return OrderBook{}, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var wire struct {
+		LastUpdateID int64      `json:"lastUpdateId"`
+		Bids         []rawLevel `json:"bids"`
+		Asks         []rawLevel `json:"asks"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&wire); err != nil {
+		return OrderBook{}, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+
+	bids, err := parseLevels(wire.Bids)
+	if err != nil {
+		return OrderBook{}, fmt.Errorf("failed to parse snapshot bids: %w", err)
+	}
+	asks, err := parseLevels(wire.Asks)
+	if err != nil {
+		return OrderBook{}, fmt.Errorf("failed to parse snapshot asks: %w", err)
+	}
+
+	return OrderBook{
+		Symbol:       s.symbol,
+		LastUpdateID: wire.LastUpdateID,
+		Bids:         bids,
+		Asks:         asks,
+		Timestamp:    time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,85 @@
+package orderbook
+
+import (
+	"context"
+	"testing"
+)
+
+// recordedDiffEvents is a short hand-written sequence of update ID ranges,
+// shaped like consecutive BTCUSDT@depth@100ms events, used to exercise the
+// continuity check without hitting the network.
+var recordedDiffEvents = []diffDepthEvent{
+	{FirstUpdateID: 157, FinalUpdateID: 160},
+	{FirstUpdateID: 161, FinalUpdateID: 161},
+	{FirstUpdateID: 162, FinalUpdateID: 165},
+}
+
+func TestContinuousAcceptsConsecutiveSpotEvents(t *testing.T) {
+	lastAppliedU := int64(156)
+	for i, event := range recordedDiffEvents {
+		if !continuous(event, lastAppliedU) {
+			t.Fatalf("event %d: expected continuous(%+v, %d) to be true", i, event, lastAppliedU)
+		}
+		lastAppliedU = event.FinalUpdateID
+	}
+}
+
+func TestContinuousRejectsGap(t *testing.T) {
+	// Event 161 is missing, so 162 arrives without the expected predecessor.
+	lastAppliedU := int64(160)
+	gapped := diffDepthEvent{FirstUpdateID: 162, FinalUpdateID: 165}
+
+	if continuous(gapped, lastAppliedU) {
+		t.Fatalf("expected continuous(%+v, %d) to detect the gap", gapped, lastAppliedU)
+	}
+}
+
+func TestContinuousUsesPrevFinalUpdateIDWhenPresent(t *testing.T) {
+	// On the futures diff-depth stream, pu must match the last applied u
+	// exactly, regardless of U.
+	event := diffDepthEvent{FirstUpdateID: 999, FinalUpdateID: 1005, PrevFinalUpdateID: 160}
+
+	if !continuous(event, 160) {
+		t.Fatalf("expected pu-based continuity to accept a matching predecessor")
+	}
+	if continuous(event, 161) {
+		t.Fatalf("expected pu-based continuity to reject a mismatched predecessor")
+	}
+}
+
+func TestWaitForSyncPointDiscardsStaleAndFindsAnchor(t *testing.T) {
+	events := make(chan diffDepthEvent, 10)
+	readErrs := make(chan error, 1)
+
+	// Stale events that predate the snapshot's lastUpdateId (158) must be discarded.
+	events <- diffDepthEvent{FirstUpdateID: 150, FinalUpdateID: 155}
+	events <- diffDepthEvent{FirstUpdateID: 156, FinalUpdateID: 157}
+	// This is the anchor: U(157) <= lastUpdateId+1(159) <= u(160).
+	events <- diffDepthEvent{FirstUpdateID: 157, FinalUpdateID: 160}
+	events <- diffDepthEvent{FirstUpdateID: 161, FinalUpdateID: 161}
+
+	first, buffered, err := waitForSyncPoint(context.Background(), events, readErrs, 158)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.FirstUpdateID != 157 || first.FinalUpdateID != 160 {
+		t.Fatalf("got anchor %+v, want U=157 u=160", first)
+	}
+	if len(buffered) != 1 || buffered[0].FirstUpdateID != 161 {
+		t.Fatalf("got buffered %+v, want a single event starting at 161", buffered)
+	}
+}
+
+func TestWaitForSyncPointDetectsGapBeforeAnchor(t *testing.T) {
+	events := make(chan diffDepthEvent, 10)
+	readErrs := make(chan error, 1)
+
+	// The first usable event starts after lastUpdateId+1, so there is a gap
+	// the buffered stream cannot bridge and a fresh snapshot is required.
+	events <- diffDepthEvent{FirstUpdateID: 200, FinalUpdateID: 205}
+
+	_, _, err := waitForSyncPoint(context.Background(), events, readErrs, 157)
+	if err != errSyncGap {
+		t.Fatalf("got err %v, want errSyncGap", err)
+	}
+}
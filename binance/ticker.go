@@ -0,0 +1,171 @@
+package orderbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook/reconnect"
+	"github.com/gorilla/websocket"
+)
+
+// BookTicker is the best bid/offer for a symbol, as delivered by Binance's
+// <symbol>@bookTicker stream. It is much cheaper to consume than the full
+// diff-depth stream for users who only need the current spread.
+type BookTicker struct {
+	Symbol    string
+	BidPrice  float64
+	BidQty    float64
+	AskPrice  float64
+	AskQty    float64
+	UpdateID  int64
+	Timestamp time.Time
+}
+
+// wireBookTicker mirrors Binance's bookTicker JSON payload
+type wireBookTicker struct {
+	UpdateID int64  `json:"u"`
+	Symbol   string `json:"s"`
+	BidPrice string `json:"b"`
+	BidQty   string `json:"B"`
+	AskPrice string `json:"a"`
+	AskQty   string `json:"A"`
+}
+
+// tickerSync keeps a BookTicker stream alive for one symbol, reconnecting
+// with exponential backoff on connection drops, and fans each update out to
+// every subscriber registered on client via client.publishTicker.
+type tickerSync struct {
+	client *BinanceClient
+	symbol string
+	done   chan struct{}
+}
+
+func newTickerSync(client *BinanceClient, symbol string) *tickerSync {
+	return &tickerSync{
+		client: client,
+		symbol: symbol,
+		done:   make(chan struct{}),
+	}
+}
+
+func (t *tickerSync) stop() {
+	close(t.done)
+}
+
+func (t *tickerSync) run(ctx context.Context) {
+	backoff := reconnect.NewBackoff(reconnect.DefaultBackoffConfig())
+	breaker := reconnect.NewCircuitBreaker(reconnect.DefaultCircuitBreakerConfig())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.done:
+			return
+		default:
+		}
+
+		if breaker.Open() {
+			if !t.sleep(ctx, backoff.Next()) {
+				return
+			}
+			continue
+		}
+
+		if err := t.streamOnce(ctx); err != nil {
+			breaker.RecordError()
+			if !t.sleep(ctx, backoff.Next()) {
+				return
+			}
+			continue
+		}
+
+		breaker.RecordSuccess()
+		backoff.Reset()
+	}
+}
+
+func (t *tickerSync) sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	case <-t.done:
+		return false
+	}
+}
+
+func (t *tickerSync) streamOnce(ctx context.Context) error {
+	u := url.URL{
+		Scheme: "wss",
+		Host:   "stream.binance.com:9443",
+		Path:   "/ws/" + strings.ToLower(t.symbol) + "@bookTicker",
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("websocket connection error: %w", err)
+	}
+	defer conn.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.done:
+			return nil
+		default:
+		}
+
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("read: %w", err)
+		}
+
+		bt, err := parseBookTicker(msg)
+		if err != nil {
+			continue
+		}
+
+		t.client.publishTicker(t.symbol, bt)
+	}
+}
+
+func parseBookTicker(msg []byte) (BookTicker, error) {
+	var wire wireBookTicker
+	if err := json.Unmarshal(msg, &wire); err != nil {
+		return BookTicker{}, fmt.Errorf("failed to unmarshal bookTicker: %w", err)
+	}
+
+	bidPrice, err := strconv.ParseFloat(wire.BidPrice, 64)
+	if err != nil {
+		return BookTicker{}, err
+	}
+	bidQty, err := strconv.ParseFloat(wire.BidQty, 64)
+	if err != nil {
+		return BookTicker{}, err
+	}
+	askPrice, err := strconv.ParseFloat(wire.AskPrice, 64)
+	if err != nil {
+		return BookTicker{}, err
+	}
+	askQty, err := strconv.ParseFloat(wire.AskQty, 64)
+	if err != nil {
+		return BookTicker{}, err
+	}
+
+	return BookTicker{
+		Symbol:    wire.Symbol,
+		BidPrice:  bidPrice,
+		BidQty:    bidQty,
+		AskPrice:  askPrice,
+		AskQty:    askQty,
+		UpdateID:  wire.UpdateID,
+		Timestamp: time.Now().UTC(),
+	}, nil
+}
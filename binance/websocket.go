@@ -1,20 +1,83 @@
 package orderbook
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
-	"log/slog"
-	"sync"
+	"strconv"
 	"time"
-
-	"github.com/gorilla/websocket"
 )
 
-func (c *BinanceClient) readMessages() {
-	// Основной цикл чтения сообщений из WebSocket
+// rawLevel is a [price, quantity] pair as sent on the wire
+type rawLevel [2]string
+
+// diffDepthEvent is a single <symbol>@depth diff-depth update
+type diffDepthEvent struct {
+	EventTime         int64
+	Symbol            string
+	FirstUpdateID     int64 // U
+	FinalUpdateID     int64 // u
+	PrevFinalUpdateID int64 // pu, present on the futures stream; zero if absent
+	Bids              []OrderBookLevel
+	Asks              []OrderBookLevel
+}
+
+// wireDiffDepthEvent mirrors Binance's diffDepthEvent JSON payload
+type wireDiffDepthEvent struct {
+	EventTime         int64      `json:"E"`
+	Symbol            string     `json:"s"`
+	FirstUpdateID     int64      `json:"U"`
+	FinalUpdateID     int64      `json:"u"`
+	PrevFinalUpdateID int64      `json:"pu"`
+	Bids              []rawLevel `json:"b"`
+	Asks              []rawLevel `json:"a"`
 }
 
-func (c *BinanceClient) processMessage(msg []byte) {
-	// Обработка и рассылка обновлений
-} 
\ No newline at end of file
+// parseDiffDepthEvent decodes a raw WebSocket frame into a diffDepthEvent
+func parseDiffDepthEvent(msg []byte) (diffDepthEvent, error) {
+	var wire wireDiffDepthEvent
+	if err := json.Unmarshal(msg, &wire); err != nil {
+		return diffDepthEvent{}, fmt.Errorf("failed to unmarshal depth update: %w", err)
+	}
+
+	bids, err := parseLevels(wire.Bids)
+	if err != nil {
+		return diffDepthEvent{}, fmt.Errorf("failed to parse bid levels: %w", err)
+	}
+	asks, err := parseLevels(wire.Asks)
+	if err != nil {
+		return diffDepthEvent{}, fmt.Errorf("failed to parse ask levels: %w", err)
+	}
+
+	return diffDepthEvent{
+		EventTime:         wire.EventTime,
+		Symbol:            wire.Symbol,
+		FirstUpdateID:     wire.FirstUpdateID,
+		FinalUpdateID:     wire.FinalUpdateID,
+		PrevFinalUpdateID: wire.PrevFinalUpdateID,
+		Bids:              bids,
+		Asks:              asks,
+	}, nil
+}
+
+func parseLevels(raw []rawLevel) ([]OrderBookLevel, error) {
+	levels := make([]OrderBookLevel, 0, len(raw))
+	for _, level := range raw {
+		price, err := strconv.ParseFloat(level[0], 64)
+		if err != nil {
+			return nil, err
+		}
+		quantity, err := strconv.ParseFloat(level[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, OrderBookLevel{Price: price, Quantity: quantity})
+	}
+	return levels, nil
+}
+
+func unixMillisToTime(ms int64) time.Time {
+	if ms == 0 {
+		return time.Now().UTC()
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,67 @@
+// Command lob_record subscribes to a live exchange's order book and
+// persists every update to a binary log for later replay via lob_replay.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/VladKochetov007/lob_view/pkg/exchanges"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook/record"
+)
+
+func main() {
+	exchangeName := flag.String("exchange", "binance", "exchange to record")
+	symbol := flag.String("symbol", "BTC/USDT", "trading pair symbol")
+	out := flag.String("out", "orderbook.log", "output log file path")
+	flag.Parse()
+
+	registry := exchanges.NewDefaultRegistry()
+	source, err := registry.New(*exchangeName, *symbol)
+	if err != nil {
+		fmt.Printf("Error creating %s provider: %v\n", *exchangeName, err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Connecting to exchange...")
+	if err := source.Connect(); err != nil {
+		fmt.Printf("Error connecting: %v\n", err)
+		os.Exit(1)
+	}
+	defer source.Disconnect()
+
+	updates, err := source.SubscribeOrderBook()
+	if err != nil {
+		fmt.Printf("Error subscribing to order book: %v\n", err)
+		os.Exit(1)
+	}
+
+	recorder, err := record.NewRecorder(*out, *symbol)
+	if err != nil {
+		fmt.Printf("Error creating recorder: %v\n", err)
+		os.Exit(1)
+	}
+	defer recorder.Close()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	fmt.Printf("Recording %s on %s to %s\n", *symbol, *exchangeName, *out)
+
+	count := 0
+	go func() {
+		for ob := range updates {
+			if err := recorder.Write(ob); err != nil {
+				fmt.Printf("Error writing record: %v\n", err)
+				continue
+			}
+			count++
+		}
+	}()
+
+	<-sigChan
+	fmt.Printf("\nRecorded %d updates to %s\n", count, *out)
+}
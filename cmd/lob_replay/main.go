@@ -0,0 +1,38 @@
+// Command lob_replay re-emits a log recorded by lob_record, preserving
+// inter-event timing (scaled by an optional speed multiplier), and displays
+// it the same way lob_view displays a live order book.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/VladKochetov007/lob_view/pkg/exchanges"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook/record"
+)
+
+func main() {
+	path := flag.String("in", "orderbook.log", "log file to replay")
+	speed := flag.Float64("speed", 1, "replay speed multiplier (<=0 replays as fast as possible)")
+	depth := flag.Int("depth", 10, "number of price levels to display")
+	flag.Parse()
+
+	source := record.NewReplaySource(*path, *speed)
+
+	fmt.Printf("Opening %s...\n", *path)
+	if err := source.Connect(); err != nil {
+		fmt.Printf("Error opening %s: %v\n", *path, err)
+		os.Exit(1)
+	}
+	defer source.Disconnect()
+
+	updates, err := source.SubscribeOrderBook()
+	if err != nil {
+		fmt.Printf("Error subscribing to replay: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Replaying %s (%s) at %.2fx speed\n", *path, source.GetSymbol(), *speed)
+	exchanges.DisplayOrderBookContinuously(updates, *depth)
+}
@@ -0,0 +1,39 @@
+// Command lob_scan displays a scrolling table of the top gainers and losers
+// across all Binance symbols, driven by the !miniTicker@arr stream.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	binance "github.com/VladKochetov007/lob_view/binance"
+	"github.com/VladKochetov007/lob_view/pkg/exchanges"
+)
+
+func main() {
+	topN := flag.Int("n", 10, "number of gainers/losers to display")
+	flag.Parse()
+
+	fmt.Printf("Starting LOB Scanner, top %d gainers/losers\n", *topN)
+
+	client := binance.NewBinanceClient()
+	defer client.Close()
+
+	tickers, err := client.SubscribeAllMiniTickers()
+	if err != nil {
+		fmt.Printf("Error subscribing to mini tickers: %v\n", err)
+		os.Exit(1)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	fmt.Println("Waiting for ticker data...")
+	go exchanges.DisplayMiniTickerScanContinuously(tickers, *topN)
+
+	<-sigChan
+	fmt.Println("\nShutting down...")
+}
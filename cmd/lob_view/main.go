@@ -2,58 +2,116 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/VladKochetov007/lob_view/pkg/exchanges"
-	"github.com/VladKochetov007/lob_view/pkg/exchanges/binance"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
 )
 
+// exchangeFlag collects repeated --exchange flags into a slice
+type exchangeFlag []string
+
+func (f *exchangeFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *exchangeFlag) Set(value string) error {
+	*f = append(*f, strings.ToLower(value))
+	return nil
+}
+
 func main() {
-	// Фиксированные параметры для простоты примера
-	symbol := "BTC/USDT"
-	depth := 10
+	if len(os.Args) > 1 && os.Args[1] == "twap" {
+		runTWAP(os.Args[2:])
+		return
+	}
+
+	var exchangeNames exchangeFlag
+	flag.Var(&exchangeNames, "exchange", "exchange to display (repeatable), e.g. -exchange binance -exchange bybit")
+	symbol := flag.String("symbol", "BTC/USDT", "trading pair symbol")
+	depth := flag.Int("depth", 10, "number of price levels to display")
+	mode := flag.String("mode", "depth", "display mode: depth (full order book, merges every -exchange), ticker (compact one-line BBO for a single -exchange), or bbo (full depth with ticker-speed top of book for a single -exchange)")
+	flag.Parse()
 
-	fmt.Printf("Starting LOB Viewer for %s\n", symbol)
+	if len(exchangeNames) == 0 {
+		exchangeNames = exchangeFlag{"binance"}
+	}
+
+	switch *mode {
+	case "depth":
+		runDepth(exchangeNames, *symbol, *depth)
+	case "ticker":
+		if len(exchangeNames) != 1 {
+			fmt.Printf("-mode ticker requires exactly one -exchange\n")
+			os.Exit(1)
+		}
+		runTicker(exchangeNames[0], *symbol)
+	case "bbo":
+		if len(exchangeNames) != 1 {
+			fmt.Printf("-mode bbo requires exactly one -exchange\n")
+			os.Exit(1)
+		}
+		runBBO(exchangeNames[0], *symbol, *depth)
+	default:
+		fmt.Printf("Unknown -mode: %s\n", *mode)
+		os.Exit(1)
+	}
+}
+
+// runDepth displays the merged full order book from one or more exchanges
+func runDepth(exchangeNames exchangeFlag, symbol string, depth int) {
+	fmt.Printf("Starting LOB Viewer for %s on %s\n", symbol, strings.Join(exchangeNames, ", "))
 	fmt.Printf("Displaying top %d levels\n", depth)
-	
-	// Create Binance order book provider
-	provider := binance.NewBinanceOrderBookProvider(symbol)
-	
-	// Connect to the exchange
-	fmt.Println("Connecting to Binance...")
-	if err := provider.Connect(); err != nil {
-		fmt.Printf("Error connecting to Binance: %v\n", err)
+
+	registry := exchanges.NewDefaultRegistry()
+	sources := make(map[string]orderbook.OrderBookSource, len(exchangeNames))
+	for _, name := range exchangeNames {
+		source, err := registry.New(name, symbol)
+		if err != nil {
+			fmt.Printf("Error creating %s provider: %v\n", name, err)
+			os.Exit(1)
+		}
+		sources[name] = source
+	}
+
+	merged := exchanges.NewMergedSource(sources)
+
+	fmt.Println("Connecting to exchanges...")
+	if err := merged.Connect(); err != nil {
+		fmt.Printf("Error connecting: %v\n", err)
 		os.Exit(1)
 	}
-	
+
 	// Setup clean shutdown
-	defer provider.Disconnect()
-	
+	defer merged.Disconnect()
+
 	// Subscribe to order book updates
-	updates, err := provider.SubscribeOrderBook()
+	updates, err := merged.Subscribe()
 	if err != nil {
 		fmt.Printf("Error subscribing to order book: %v\n", err)
 		os.Exit(1)
 	}
-	
+
 	// Setup signal handling for graceful shutdown
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	
+
 	// Start displaying the order book
 	fmt.Println("Waiting for order book data...")
-	
+
 	// Небольшая задержка для получения стабильных данных
 	time.Sleep(2 * time.Second)
-	
+
 	// Run in a separate goroutine so we can handle signals
-	go exchanges.DisplayOrderBookContinuously(updates, depth)
-	
+	go exchanges.DisplayMergedOrderBooksContinuously(updates, depth)
+
 	// Wait for termination signal
 	<-sigChan
 	fmt.Println("\nShutting down...")
-} 
\ No newline at end of file
+}
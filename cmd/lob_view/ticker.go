@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/VladKochetov007/lob_view/pkg/exchanges"
+)
+
+// runTicker implements "lob_view -mode ticker": displays a compact one-line
+// BBO view for a single exchange, driven by its BookTicker stream instead
+// of the full order book.
+func runTicker(exchangeName, symbol string) {
+	fmt.Printf("Starting LOB Ticker for %s on %s\n", symbol, exchangeName)
+
+	registry := exchanges.NewDefaultRegistry()
+	source, err := registry.New(exchangeName, symbol)
+	if err != nil {
+		fmt.Printf("Error creating %s provider: %v\n", exchangeName, err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Connecting to exchange...")
+	if err := source.Connect(); err != nil {
+		fmt.Printf("Error connecting: %v\n", err)
+		os.Exit(1)
+	}
+	defer source.Disconnect()
+
+	tickers, err := source.SubscribeBookTicker()
+	if err != nil {
+		fmt.Printf("Error subscribing to bookTicker: %v\n", err)
+		os.Exit(1)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	fmt.Println("Waiting for ticker data...")
+	go exchanges.DisplayBookTickerContinuously(tickers)
+
+	<-sigChan
+	fmt.Println("\nShutting down...")
+}
+
+// runBBO implements "lob_view -mode bbo": displays the full order book for
+// a single exchange with its top level kept at ticker speed, by merging
+// the depth and BookTicker streams.
+func runBBO(exchangeName, symbol string, depth int) {
+	fmt.Printf("Starting LOB Viewer (BBO-merged) for %s on %s\n", symbol, exchangeName)
+	fmt.Printf("Displaying top %d levels\n", depth)
+
+	registry := exchanges.NewDefaultRegistry()
+	source, err := registry.New(exchangeName, symbol)
+	if err != nil {
+		fmt.Printf("Error creating %s provider: %v\n", exchangeName, err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Connecting to exchange...")
+	if err := source.Connect(); err != nil {
+		fmt.Printf("Error connecting: %v\n", err)
+		os.Exit(1)
+	}
+	defer source.Disconnect()
+
+	depthUpdates, err := source.SubscribeOrderBook()
+	if err != nil {
+		fmt.Printf("Error subscribing to order book: %v\n", err)
+		os.Exit(1)
+	}
+	tickers, err := source.SubscribeBookTicker()
+	if err != nil {
+		fmt.Printf("Error subscribing to bookTicker: %v\n", err)
+		os.Exit(1)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+
+	fmt.Println("Waiting for order book data...")
+	merged := exchanges.MergeDepthWithBBO(depthUpdates, tickers)
+	go exchanges.DisplayOrderBookContinuously(merged, depth)
+
+	<-sigChan
+	fmt.Println("\nShutting down...")
+}
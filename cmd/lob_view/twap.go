@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/exchanges"
+	"github.com/VladKochetov007/lob_view/pkg/execsim"
+)
+
+// runTWAP implements the "lob_view twap" subcommand: simulates TWAP
+// execution of a parent order against a single exchange's live order book
+// and prints a per-slice execution report.
+func runTWAP(args []string) {
+	fs := flag.NewFlagSet("twap", flag.ExitOnError)
+	exchangeName := fs.String("exchange", "binance", "exchange to execute against")
+	symbol := fs.String("symbol", "BTC/USDT", "trading pair symbol")
+	side := fs.String("side", "buy", "order side: buy or sell")
+	qty := fs.Float64("qty", 1, "total parent order quantity")
+	duration := fs.Duration("duration", time.Minute, "total duration to work the order over")
+	sliceInterval := fs.Duration("slice-interval", 10*time.Second, "interval between child slices")
+	priceLimit := fs.Float64("price-limit", 0, "limit price; 0 disables the check")
+	fs.Parse(args)
+
+	registry := exchanges.NewDefaultRegistry()
+	source, err := registry.New(*exchangeName, *symbol)
+	if err != nil {
+		fmt.Printf("Error creating %s provider: %v\n", *exchangeName, err)
+		os.Exit(1)
+	}
+
+	if err := source.Connect(); err != nil {
+		fmt.Printf("Error connecting: %v\n", err)
+		os.Exit(1)
+	}
+	defer source.Disconnect()
+
+	params := execsim.Params{
+		Symbol:        *symbol,
+		Side:          execsim.Side(strings.ToLower(*side)),
+		TotalQty:      *qty,
+		Duration:      *duration,
+		SliceInterval: *sliceInterval,
+		PriceLimit:    *priceLimit,
+	}
+
+	reports, err := execsim.Run(context.Background(), source, params)
+	if err != nil {
+		fmt.Printf("Error starting TWAP simulation: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Simulating TWAP %s %.8f %s over %s in %s slices\n",
+		params.Side, params.TotalQty, params.Symbol, params.Duration, params.SliceInterval)
+
+	for report := range reports {
+		fmt.Printf("slice %-3d filled %-12.8f/%-12.8f @ %-12.8f  cum VWAP %-12.8f  slippage %+.2fbps  residual %.8f\n",
+			report.SliceIndex, report.FilledQty, report.RequestedQty, report.FillPrice,
+			report.CumulativeVWAP, report.SlippageBps, report.ResidualQty)
+	}
+}
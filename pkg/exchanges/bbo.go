@@ -0,0 +1,85 @@
+package exchanges
+
+import "github.com/VladKochetov007/lob_view/pkg/orderbook"
+
+// MergeDepthWithBBO overlays a higher-frequency BookTicker stream onto a
+// slower full-depth stream. Every depth update is emitted as received; every
+// BookTicker update after the first depth update re-emits the latest book
+// with its top bid/ask level replaced by that best bid/offer. BookTicker
+// updates that arrive before any depth update are dropped. This lets
+// renderers show BBO-speed top-of-book while still retaining full depth.
+func MergeDepthWithBBO(depth <-chan orderbook.OrderBook, ticker <-chan orderbook.BookTicker) <-chan orderbook.OrderBook {
+	out := make(chan orderbook.OrderBook, 100)
+
+	go func() {
+		defer close(out)
+
+		var latest orderbook.OrderBook
+		haveDepth := false
+
+		for depth != nil || ticker != nil {
+			select {
+			case ob, ok := <-depth:
+				if !ok {
+					depth = nil
+					continue
+				}
+				latest = ob
+				haveDepth = true
+				out <- latest
+			case bt, ok := <-ticker:
+				if !ok {
+					ticker = nil
+					continue
+				}
+				if !haveDepth {
+					continue
+				}
+				latest = applyBookTicker(latest, bt)
+				out <- latest
+			}
+		}
+	}()
+
+	return out
+}
+
+// applyBookTicker returns a copy of ob with its best bid/ask replaced by bt,
+// unless doing so would cross the second level. The ticker and depth streams
+// are independent connections (e.g. Binance's @bookTicker vs @depth, Bybit's
+// tickers vs orderbook.50 topic) and can drift apart momentarily, so a stale
+// or out-of-order BBO update is dropped on whichever side it would make
+// non-monotonic rather than rendered.
+func applyBookTicker(ob orderbook.OrderBook, bt orderbook.BookTicker) orderbook.OrderBook {
+	merged := ob
+	merged.LastUpdate = bt.Timestamp
+
+	if len(merged.Bids) > 0 && !crossesSecondLevel(merged.Bids, bt.BidPrice, true) {
+		bids := make([]orderbook.PriceLevel, len(merged.Bids))
+		copy(bids, merged.Bids)
+		bids[0] = orderbook.PriceLevel{Price: bt.BidPrice, Quantity: bt.BidQty}
+		merged.Bids = bids
+	}
+	if len(merged.Asks) > 0 && !crossesSecondLevel(merged.Asks, bt.AskPrice, false) {
+		asks := make([]orderbook.PriceLevel, len(merged.Asks))
+		copy(asks, merged.Asks)
+		asks[0] = orderbook.PriceLevel{Price: bt.AskPrice, Quantity: bt.AskQty}
+		merged.Asks = asks
+	}
+
+	return merged
+}
+
+// crossesSecondLevel reports whether replacing levels[0].Price with price
+// would make the side non-monotonic against levels[1]: bids sort descending,
+// so price must stay above levels[1]; asks sort ascending, so it must stay
+// below. A side with fewer than two levels has nothing to cross.
+func crossesSecondLevel(levels []orderbook.PriceLevel, price float64, descending bool) bool {
+	if len(levels) < 2 {
+		return false
+	}
+	if descending {
+		return price <= levels[1].Price
+	}
+	return price >= levels[1].Price
+}
@@ -0,0 +1,115 @@
+package exchanges
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+func testDepthBook() orderbook.OrderBook {
+	return orderbook.OrderBook{
+		Symbol: "BTCUSDT",
+		Bids: []orderbook.PriceLevel{
+			{Price: 100, Quantity: 1},
+			{Price: 99, Quantity: 2},
+		},
+		Asks: []orderbook.PriceLevel{
+			{Price: 101, Quantity: 1},
+			{Price: 102, Quantity: 2},
+		},
+	}
+}
+
+func TestApplyBookTickerOverlaysTopLevelWhenItStaysMonotonic(t *testing.T) {
+	merged := applyBookTicker(testDepthBook(), orderbook.BookTicker{
+		BidPrice: 100.5, BidQty: 3,
+		AskPrice: 100.8, AskQty: 4,
+	})
+
+	if merged.Bids[0].Price != 100.5 || merged.Bids[0].Quantity != 3 {
+		t.Errorf("expected the bid overlay to apply, got %+v", merged.Bids[0])
+	}
+	if merged.Asks[0].Price != 100.8 || merged.Asks[0].Quantity != 4 {
+		t.Errorf("expected the ask overlay to apply, got %+v", merged.Asks[0])
+	}
+}
+
+func TestApplyBookTickerDropsOverlayThatWouldCrossSecondLevel(t *testing.T) {
+	merged := applyBookTicker(testDepthBook(), orderbook.BookTicker{
+		BidPrice: 99, BidQty: 3,   // at-or-below bids[1].Price (99): would cross
+		AskPrice: 102, AskQty: 4, // at-or-above asks[1].Price (102): would cross
+	})
+
+	if merged.Bids[0].Price != 100 || merged.Bids[0].Quantity != 1 {
+		t.Errorf("expected the stale bid overlay to be dropped, got %+v", merged.Bids[0])
+	}
+	if merged.Asks[0].Price != 101 || merged.Asks[0].Quantity != 1 {
+		t.Errorf("expected the stale ask overlay to be dropped, got %+v", merged.Asks[0])
+	}
+}
+
+func TestApplyBookTickerOverlaysOneSideWhileDroppingTheOther(t *testing.T) {
+	merged := applyBookTicker(testDepthBook(), orderbook.BookTicker{
+		BidPrice: 100.5, BidQty: 3, // valid
+		AskPrice: 102, AskQty: 4,   // would cross
+	})
+
+	if merged.Bids[0].Price != 100.5 {
+		t.Errorf("expected the valid bid overlay to apply, got %+v", merged.Bids[0])
+	}
+	if merged.Asks[0].Price != 101 {
+		t.Errorf("expected the crossing ask overlay to be dropped, got %+v", merged.Asks[0])
+	}
+}
+
+func TestMergeDepthWithBBOIgnoresTickerUpdatesBeforeTheFirstDepthSnapshot(t *testing.T) {
+	// Unbuffered, so each send below only returns once MergeDepthWithBBO's
+	// goroutine has received it, keeping the two inputs strictly ordered.
+	depth := make(chan orderbook.OrderBook)
+	ticker := make(chan orderbook.BookTicker)
+
+	out := MergeDepthWithBBO(depth, ticker)
+
+	select {
+	case ticker <- orderbook.BookTicker{BidPrice: 1, AskPrice: 2}:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out sending the pre-snapshot ticker update")
+	}
+
+	select {
+	case ob := <-out:
+		t.Fatalf("expected no output before the first depth snapshot, got %+v", ob)
+	default:
+	}
+
+	select {
+	case depth <- testDepthBook():
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out sending the depth snapshot")
+	}
+
+	select {
+	case ob, ok := <-out:
+		if !ok {
+			t.Fatal("expected the depth snapshot to be emitted")
+		}
+		if ob.Bids[0].Price != 100 {
+			t.Errorf("expected the untouched depth snapshot, got %+v", ob.Bids[0])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for merged output")
+	}
+
+	close(depth)
+	close(ticker)
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("expected out to close once both inputs close")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for out to close")
+	}
+}
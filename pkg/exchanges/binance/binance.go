@@ -2,44 +2,31 @@
 package binance
 
 import (
-	"encoding/json"
 	"fmt"
-	"github.com/VladKochetov007/lob_view/pkg/orderbook"
-	"net/url"
-	"strconv"
 	"strings"
-	"time"
-
-	"github.com/gorilla/websocket"
-)
 
-const (
-	binanceWsURL = "wss://stream.binance.com:9443/ws"
+	binanceclient "github.com/VladKochetov007/lob_view/binance"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
 )
 
-// BinanceOrderBookProvider implements the OrderBookSource interface for Binance
+// BinanceOrderBookProvider implements the OrderBookSource interface for
+// Binance by adapting binanceclient.BinanceClient onto this package's
+// contract. BinanceClient does the real work: a REST snapshot buffered
+// against the diff-depth WebSocket stream, with continuity (U/u/pu)
+// checks and automatic resync on any gap, per Binance's documented
+// synchronization procedure.
 type BinanceOrderBookProvider struct {
-	symbol     string
-	conn       *websocket.Conn
-	done       chan struct{}
-	orderbooks chan orderbook.OrderBook
-}
-
-// BinanceOrderBookResponse represents the response from Binance WebSocket API
-type BinanceOrderBookResponse struct {
-	LastUpdateID int64       `json:"lastUpdateId"`
-	Bids         [][2]string `json:"bids"`
-	Asks         [][2]string `json:"asks"`
-	Symbol       string      `json:"s"`
-	EventTime    int64       `json:"E"`
+	symbol string
+	client *binanceclient.BinanceClient
+	done   chan struct{}
 }
 
 // NewBinanceOrderBookProvider creates a new Binance order book provider
 func NewBinanceOrderBookProvider(symbol string) *BinanceOrderBookProvider {
 	return &BinanceOrderBookProvider{
-		symbol:     strings.ToLower(symbol),
-		done:       make(chan struct{}),
-		orderbooks: make(chan orderbook.OrderBook, 100),
+		symbol: strings.ToLower(symbol),
+		client: binanceclient.NewBinanceClient(),
+		done:   make(chan struct{}),
 	}
 }
 
@@ -48,110 +35,106 @@ func (p *BinanceOrderBookProvider) GetSymbol() string {
 	return p.symbol
 }
 
-// Connect establishes a connection to Binance WebSocket API
+// Connect is a no-op: BinanceClient dials lazily, per symbol, the first
+// time SubscribeOrderBook or SubscribeBookTicker is called
 func (p *BinanceOrderBookProvider) Connect() error {
-	// Format symbol by removing slash if present
-	formattedSymbol := strings.ReplaceAll(p.symbol, "/", "")
-	
-	// Create WebSocket connection
-	u := url.URL{Scheme: "wss", Host: "stream.binance.com:9443", Path: "/ws/" + strings.ToLower(formattedSymbol) + "@depth20@100ms"}
-	
-	var err error
-	p.conn, _, err = websocket.DefaultDialer.Dial(u.String(), nil)
-	if err != nil {
-		return fmt.Errorf("error connecting to Binance WebSocket: %w", err)
-	}
-	
 	return nil
 }
 
-// Disconnect closes the WebSocket connection
+// Disconnect stops every in-flight synchronization and closes the client
 func (p *BinanceOrderBookProvider) Disconnect() error {
 	close(p.done)
-	if p.conn != nil {
-		return p.conn.Close()
-	}
-	return nil
+	return p.client.Close()
 }
 
-// SubscribeOrderBook subscribes to order book updates
+// SubscribeOrderBook subscribes to order book updates, keeping the book
+// synchronized via BinanceClient's snapshot + buffered-diff procedure
 func (p *BinanceOrderBookProvider) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
-	go p.listenForUpdates()
-	return p.orderbooks, nil
+	events, err := p.client.Subscribe(p.symbol)
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to Binance order book: %w", err)
+	}
+
+	orderbooks := make(chan orderbook.OrderBook, 100)
+	go p.forwardOrderBook(events, orderbooks)
+	return orderbooks, nil
 }
 
-// listenForUpdates listens for WebSocket messages and processes them
-func (p *BinanceOrderBookProvider) listenForUpdates() {
-	defer close(p.orderbooks)
-	
+// forwardOrderBook translates BinanceClient's OrderBookEvent stream into
+// orderbook.OrderBook. Sync errors are logged rather than surfaced, since
+// BinanceClient already retries internally with backoff and a circuit breaker.
+func (p *BinanceOrderBookProvider) forwardOrderBook(events <-chan binanceclient.OrderBookEvent, orderbooks chan<- orderbook.OrderBook) {
+	defer close(orderbooks)
+
 	for {
 		select {
 		case <-p.done:
 			return
-		default:
-			_, message, err := p.conn.ReadMessage()
-			if err != nil {
-				fmt.Printf("Error reading message: %v\n", err)
+		case event, ok := <-events:
+			if !ok {
 				return
 			}
-			
-			var response BinanceOrderBookResponse
-			if err := json.Unmarshal(message, &response); err != nil {
-				fmt.Printf("Error unmarshalling message: %v\n", err)
+			if event.Error != nil {
+				fmt.Printf("Error syncing Binance order book: %v\n", event.Error)
 				continue
 			}
-			
-			p.processOrderBookUpdate(response)
+			orderbooks <- convertOrderBook(event.OrderBook)
 		}
 	}
 }
 
-// processOrderBookUpdate processes an order book update from Binance
-func (p *BinanceOrderBookProvider) processOrderBookUpdate(response BinanceOrderBookResponse) {
-	// Create a new order book
-	ob := orderbook.OrderBook{
-		Symbol:     p.symbol,
-		LastUpdate: time.Unix(0, response.EventTime*int64(time.Millisecond)),
-		Bids:       make([]orderbook.PriceLevel, 0, len(response.Bids)),
-		Asks:       make([]orderbook.PriceLevel, 0, len(response.Asks)),
+// convertOrderBook adapts a binanceclient.OrderBook onto orderbook.OrderBook
+func convertOrderBook(ob binanceclient.OrderBook) orderbook.OrderBook {
+	out := orderbook.OrderBook{
+		Symbol:     ob.Symbol,
+		LastUpdate: ob.Timestamp,
+		Bids:       make([]orderbook.PriceLevel, len(ob.Bids)),
+		Asks:       make([]orderbook.PriceLevel, len(ob.Asks)),
 	}
-	
-	// Process bids
-	for _, bid := range response.Bids {
-		price, err := strconv.ParseFloat(bid[0], 64)
-		if err != nil {
-			continue
-		}
-		
-		quantity, err := strconv.ParseFloat(bid[1], 64)
-		if err != nil {
-			continue
-		}
-		
-		ob.Bids = append(ob.Bids, orderbook.PriceLevel{
-			Price:    price,
-			Quantity: quantity,
-		})
+	for i, level := range ob.Bids {
+		out.Bids[i] = orderbook.PriceLevel{Price: level.Price, Quantity: level.Quantity}
 	}
-	
-	// Process asks
-	for _, ask := range response.Asks {
-		price, err := strconv.ParseFloat(ask[0], 64)
-		if err != nil {
-			continue
-		}
-		
-		quantity, err := strconv.ParseFloat(ask[1], 64)
-		if err != nil {
-			continue
+	for i, level := range ob.Asks {
+		out.Asks[i] = orderbook.PriceLevel{Price: level.Price, Quantity: level.Quantity}
+	}
+	return out
+}
+
+// SubscribeBookTicker subscribes to best bid/offer updates for the
+// provider's symbol via BinanceClient's independent bookTicker stream,
+// which runs on its own connection regardless of the depth subscription.
+func (p *BinanceOrderBookProvider) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	tickers, err := p.client.SubscribeBookTicker(p.symbol)
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to Binance bookTicker: %w", err)
+	}
+
+	out := make(chan orderbook.BookTicker, 100)
+	go p.forwardBookTicker(tickers, out)
+	return out, nil
+}
+
+// forwardBookTicker translates BinanceClient's BookTicker stream into orderbook.BookTicker
+func (p *BinanceOrderBookProvider) forwardBookTicker(tickers <-chan binanceclient.BookTicker, out chan<- orderbook.BookTicker) {
+	defer close(out)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case bt, ok := <-tickers:
+			if !ok {
+				return
+			}
+			out <- orderbook.BookTicker{
+				Symbol:    bt.Symbol,
+				BidPrice:  bt.BidPrice,
+				BidQty:    bt.BidQty,
+				AskPrice:  bt.AskPrice,
+				AskQty:    bt.AskQty,
+				UpdateID:  bt.UpdateID,
+				Timestamp: bt.Timestamp,
+			}
 		}
-		
-		ob.Asks = append(ob.Asks, orderbook.PriceLevel{
-			Price:    price,
-			Quantity: quantity,
-		})
 	}
-	
-	// Send the order book to the channel
-	p.orderbooks <- ob
-} 
\ No newline at end of file
+}
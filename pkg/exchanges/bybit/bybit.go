@@ -0,0 +1,326 @@
+// Package bybit provides implementation of OrderBookSource for the Bybit exchange
+package bybit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	bybitWsURL = "wss://stream.bybit.com/v5/public/spot"
+	bybitDepth = 50
+)
+
+// BybitOrderBookProvider implements the OrderBookSource interface for Bybit.
+// The orderbook.50 topic sends a full snapshot followed by delta messages
+// carrying only changed levels, so the provider keeps the running book in
+// memory and re-emits the full book on every update.
+type BybitOrderBookProvider struct {
+	symbol      string
+	conn        *websocket.Conn
+	tickersConn *websocket.Conn
+	done        chan struct{}
+	orderbooks  chan orderbook.OrderBook
+
+	mu   sync.Mutex
+	bids map[float64]float64
+	asks map[float64]float64
+}
+
+// bybitOrderBookMessage represents a message from Bybit's orderbook topic
+type bybitOrderBookMessage struct {
+	Topic string `json:"topic"`
+	Type  string `json:"type"`
+	Data  struct {
+		Symbol string      `json:"s"`
+		Bids   [][2]string `json:"b"`
+		Asks   [][2]string `json:"a"`
+	} `json:"data"`
+	Ts int64 `json:"ts"`
+}
+
+// NewBybitOrderBookProvider creates a new Bybit order book provider
+func NewBybitOrderBookProvider(symbol string) *BybitOrderBookProvider {
+	return &BybitOrderBookProvider{
+		symbol:     strings.ToUpper(strings.ReplaceAll(symbol, "/", "")),
+		done:       make(chan struct{}),
+		orderbooks: make(chan orderbook.OrderBook, 100),
+		bids:       make(map[float64]float64),
+		asks:       make(map[float64]float64),
+	}
+}
+
+// GetSymbol returns the trading pair symbol
+func (p *BybitOrderBookProvider) GetSymbol() string {
+	return p.symbol
+}
+
+// Connect establishes a connection to Bybit's public WebSocket API
+func (p *BybitOrderBookProvider) Connect() error {
+	u, err := url.Parse(bybitWsURL)
+	if err != nil {
+		return fmt.Errorf("error parsing Bybit WebSocket URL: %w", err)
+	}
+
+	p.conn, _, err = websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to Bybit WebSocket: %w", err)
+	}
+
+	sub := map[string]any{
+		"op":   "subscribe",
+		"args": []string{fmt.Sprintf("orderbook.%d.%s", bybitDepth, p.symbol)},
+	}
+	if err := p.conn.WriteJSON(sub); err != nil {
+		return fmt.Errorf("error subscribing to Bybit orderbook topic: %w", err)
+	}
+
+	return nil
+}
+
+// Disconnect closes the WebSocket connection
+func (p *BybitOrderBookProvider) Disconnect() error {
+	close(p.done)
+	if p.tickersConn != nil {
+		p.tickersConn.Close()
+	}
+	if p.conn != nil {
+		return p.conn.Close()
+	}
+	return nil
+}
+
+// SubscribeOrderBook subscribes to order book updates
+func (p *BybitOrderBookProvider) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	go p.listenForUpdates()
+	return p.orderbooks, nil
+}
+
+// SubscribeBookTicker subscribes to best bid/offer updates for the
+// provider's symbol via Bybit's spot "tickers" topic, on its own connection
+// since it runs independently of the orderbook stream opened by Connect.
+func (p *BybitOrderBookProvider) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(bybitWsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Bybit WebSocket: %w", err)
+	}
+	p.tickersConn = conn
+
+	sub := map[string]any{
+		"op":   "subscribe",
+		"args": []string{fmt.Sprintf("tickers.%s", p.symbol)},
+	}
+	if err := conn.WriteJSON(sub); err != nil {
+		return nil, fmt.Errorf("error subscribing to Bybit tickers topic: %w", err)
+	}
+
+	tickers := make(chan orderbook.BookTicker, 100)
+	go p.listenForBookTicker(conn, tickers)
+	return tickers, nil
+}
+
+// listenForUpdates listens for WebSocket messages and processes them
+func (p *BybitOrderBookProvider) listenForUpdates() {
+	defer close(p.orderbooks)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := p.conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var msg bybitOrderBookMessage
+			if err := json.Unmarshal(message, &msg); err != nil {
+				continue
+			}
+			if msg.Topic == "" {
+				// Control frames (subscribe ack, pong, ...) carry no topic
+				continue
+			}
+
+			switch msg.Type {
+			case "snapshot":
+				p.applySnapshot(msg)
+			case "delta":
+				p.applyDelta(msg)
+			default:
+				continue
+			}
+
+			p.orderbooks <- p.buildOrderBook(msg.Ts)
+		}
+	}
+}
+
+// applySnapshot replaces the in-memory book with the initial orderbook.50 snapshot
+func (p *BybitOrderBookProvider) applySnapshot(msg bybitOrderBookMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.bids = make(map[float64]float64, len(msg.Data.Bids))
+	p.asks = make(map[float64]float64, len(msg.Data.Asks))
+	for _, level := range msg.Data.Bids {
+		setLevel(p.bids, level)
+	}
+	for _, level := range msg.Data.Asks {
+		setLevel(p.asks, level)
+	}
+}
+
+// applyDelta merges a delta message's changed levels into the in-memory
+// book, removing a level when its quantity is zero
+func (p *BybitOrderBookProvider) applyDelta(msg bybitOrderBookMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	mergeLevels(p.bids, msg.Data.Bids)
+	mergeLevels(p.asks, msg.Data.Asks)
+}
+
+// setLevel parses a [price, quantity] pair from a snapshot message into levels
+func setLevel(levels map[float64]float64, level [2]string) {
+	price, err := strconv.ParseFloat(level[0], 64)
+	if err != nil {
+		return
+	}
+	quantity, err := strconv.ParseFloat(level[1], 64)
+	if err != nil {
+		return
+	}
+	levels[price] = quantity
+}
+
+// mergeLevels applies a delta message's [price, quantity] pairs onto levels,
+// deleting the level when quantity is zero
+func mergeLevels(levels map[float64]float64, changes [][2]string) {
+	for _, change := range changes {
+		price, err := strconv.ParseFloat(change[0], 64)
+		if err != nil {
+			continue
+		}
+		quantity, err := strconv.ParseFloat(change[1], 64)
+		if err != nil {
+			continue
+		}
+		if quantity == 0 {
+			delete(levels, price)
+		} else {
+			levels[price] = quantity
+		}
+	}
+}
+
+// buildOrderBook snapshots the in-memory book into a sorted orderbook.OrderBook
+func (p *BybitOrderBookProvider) buildOrderBook(ts int64) orderbook.OrderBook {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ob := orderbook.OrderBook{
+		Symbol:     p.symbol,
+		LastUpdate: time.Unix(0, ts*int64(time.Millisecond)),
+		Bids:       make([]orderbook.PriceLevel, 0, len(p.bids)),
+		Asks:       make([]orderbook.PriceLevel, 0, len(p.asks)),
+	}
+
+	for price, quantity := range p.bids {
+		ob.Bids = append(ob.Bids, orderbook.PriceLevel{Price: price, Quantity: quantity})
+	}
+	for price, quantity := range p.asks {
+		ob.Asks = append(ob.Asks, orderbook.PriceLevel{Price: price, Quantity: quantity})
+	}
+
+	sort.Slice(ob.Bids, func(i, j int) bool { return ob.Bids[i].Price > ob.Bids[j].Price })
+	sort.Slice(ob.Asks, func(i, j int) bool { return ob.Asks[i].Price < ob.Asks[j].Price })
+
+	return ob
+}
+
+// bybitTickerMessage represents a message from Bybit's spot tickers topic
+type bybitTickerMessage struct {
+	Topic string `json:"topic"`
+	Data  struct {
+		Symbol    string `json:"symbol"`
+		Bid1Price string `json:"bid1Price"`
+		Bid1Size  string `json:"bid1Size"`
+		Ask1Price string `json:"ask1Price"`
+		Ask1Size  string `json:"ask1Size"`
+	} `json:"data"`
+	Ts int64 `json:"ts"`
+}
+
+// listenForBookTicker listens for tickers-topic WebSocket messages and processes them
+func (p *BybitOrderBookProvider) listenForBookTicker(conn *websocket.Conn, tickers chan<- orderbook.BookTicker) {
+	defer close(tickers)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var msg bybitTickerMessage
+			if err := json.Unmarshal(message, &msg); err != nil {
+				continue
+			}
+			if msg.Topic == "" || msg.Data.Bid1Price == "" || msg.Data.Ask1Price == "" {
+				// Control frames, or a delta update missing the BBO fields
+				continue
+			}
+
+			bt, err := parseBookTicker(msg)
+			if err != nil {
+				continue
+			}
+
+			tickers <- bt
+		}
+	}
+}
+
+// parseBookTicker converts a bybitTickerMessage into an orderbook.BookTicker
+func parseBookTicker(msg bybitTickerMessage) (orderbook.BookTicker, error) {
+	bidPrice, err := strconv.ParseFloat(msg.Data.Bid1Price, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	bidQty, err := strconv.ParseFloat(msg.Data.Bid1Size, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askPrice, err := strconv.ParseFloat(msg.Data.Ask1Price, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askQty, err := strconv.ParseFloat(msg.Data.Ask1Size, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+
+	return orderbook.BookTicker{
+		Symbol:    msg.Data.Symbol,
+		BidPrice:  bidPrice,
+		BidQty:    bidQty,
+		AskPrice:  askPrice,
+		AskQty:    askQty,
+		Timestamp: time.Unix(0, msg.Ts*int64(time.Millisecond)),
+	}, nil
+}
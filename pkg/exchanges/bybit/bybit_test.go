@@ -0,0 +1,125 @@
+package bybit
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSetLevel(t *testing.T) {
+	cases := []struct {
+		name  string
+		level [2]string
+		want  map[float64]float64
+	}{
+		{"valid level is set", [2]string{"100.5", "2"}, map[float64]float64{100.5: 2}},
+		{"unparsable price is ignored", [2]string{"bad", "2"}, map[float64]float64{}},
+		{"unparsable quantity is ignored", [2]string{"100", "bad"}, map[float64]float64{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			levels := make(map[float64]float64)
+			setLevel(levels, c.level)
+			if len(levels) != len(c.want) {
+				t.Fatalf("got %+v, want %+v", levels, c.want)
+			}
+			for price, qty := range c.want {
+				if levels[price] != qty {
+					t.Errorf("got %v at price %v, want %v", levels[price], price, qty)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeLevels(t *testing.T) {
+	cases := []struct {
+		name    string
+		initial map[float64]float64
+		changes [][2]string
+		want    map[float64]float64
+	}{
+		{
+			name:    "upserts a new level",
+			initial: map[float64]float64{100: 1},
+			changes: [][2]string{{"101", "2"}},
+			want:    map[float64]float64{100: 1, 101: 2},
+		},
+		{
+			name:    "updates an existing level in place",
+			initial: map[float64]float64{100: 1},
+			changes: [][2]string{{"100", "5"}},
+			want:    map[float64]float64{100: 5},
+		},
+		{
+			name:    "removes a level at zero quantity",
+			initial: map[float64]float64{100: 1, 101: 2},
+			changes: [][2]string{{"100", "0"}},
+			want:    map[float64]float64{101: 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mergeLevels(c.initial, c.changes)
+			if len(c.initial) != len(c.want) {
+				t.Fatalf("got %+v, want %+v", c.initial, c.want)
+			}
+			for price, qty := range c.want {
+				if c.initial[price] != qty {
+					t.Errorf("got %v at price %v, want %v", c.initial[price], price, qty)
+				}
+			}
+		})
+	}
+}
+
+func mustUnmarshalMessage(t *testing.T, raw string) bybitOrderBookMessage {
+	t.Helper()
+	var msg bybitOrderBookMessage
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		t.Fatalf("failed to unmarshal test message: %v", err)
+	}
+	return msg
+}
+
+// TestApplyDeltaMergesOntoSnapshotAndRemovesZeroQuantityLevel covers the
+// orderbook.50 sequence this provider relies on: a full snapshot, followed by
+// a delta that both updates and removes levels.
+func TestApplyDeltaMergesOntoSnapshotAndRemovesZeroQuantityLevel(t *testing.T) {
+	p := NewBybitOrderBookProvider("BTC/USDT")
+
+	snapshot := mustUnmarshalMessage(t, `{
+		"topic": "orderbook.50.BTCUSDT",
+		"type": "snapshot",
+		"data": {"s": "BTCUSDT", "b": [["100", "1"], ["99", "2"]], "a": [["101", "1"], ["102", "2"]]},
+		"ts": 1
+	}`)
+	p.applySnapshot(snapshot)
+
+	ob := p.buildOrderBook(snapshot.Ts)
+	if len(ob.Bids) != 2 || len(ob.Asks) != 2 {
+		t.Fatalf("expected the snapshot to populate both sides, got %+v", ob)
+	}
+
+	delta := mustUnmarshalMessage(t, `{
+		"topic": "orderbook.50.BTCUSDT",
+		"type": "delta",
+		"data": {"s": "BTCUSDT", "b": [["100", "0"], ["98", "5"]], "a": []},
+		"ts": 2
+	}`)
+	p.applyDelta(delta)
+
+	ob = p.buildOrderBook(delta.Ts)
+	if len(ob.Bids) != 2 {
+		t.Fatalf("expected the delta to remove the 100 level and add 98, got %+v", ob.Bids)
+	}
+	for _, level := range ob.Bids {
+		if level.Price == 100 {
+			t.Fatalf("expected the zero-quantity level to be removed, got %+v", ob.Bids)
+		}
+	}
+	if len(ob.Asks) != 2 {
+		t.Fatalf("expected the ask side to be untouched by a bid-only delta, got %+v", ob.Asks)
+	}
+}
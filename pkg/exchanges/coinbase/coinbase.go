@@ -0,0 +1,329 @@
+// Package coinbase provides implementation of OrderBookSource for the Coinbase exchange
+package coinbase
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	coinbaseWsURL = "wss://ws-feed.exchange.coinbase.com"
+)
+
+// CoinbaseOrderBookProvider implements the OrderBookSource interface for Coinbase.
+// Coinbase's level2 channel only ever sends a single snapshot followed by
+// incremental updates, so the provider keeps the running book in memory and
+// re-emits the full book on every update.
+type CoinbaseOrderBookProvider struct {
+	symbol     string
+	conn       *websocket.Conn
+	tickerConn *websocket.Conn
+	done       chan struct{}
+	orderbooks chan orderbook.OrderBook
+
+	mu   sync.Mutex
+	bids map[float64]float64
+	asks map[float64]float64
+}
+
+// coinbaseMessage represents a message from Coinbase's level2 channel
+type coinbaseMessage struct {
+	Type      string     `json:"type"`
+	ProductID string     `json:"product_id"`
+	Bids      [][]string `json:"bids"`
+	Asks      [][]string `json:"asks"`
+	Changes   [][]string `json:"changes"`
+	Time      string     `json:"time"`
+}
+
+// coinbaseTickerMessage represents a message from Coinbase's ticker channel
+type coinbaseTickerMessage struct {
+	Type        string `json:"type"`
+	ProductID   string `json:"product_id"`
+	Sequence    int64  `json:"sequence"`
+	BestBid     string `json:"best_bid"`
+	BestBidSize string `json:"best_bid_size"`
+	BestAsk     string `json:"best_ask"`
+	BestAskSize string `json:"best_ask_size"`
+	Time        string `json:"time"`
+}
+
+// NewCoinbaseOrderBookProvider creates a new Coinbase order book provider
+func NewCoinbaseOrderBookProvider(symbol string) *CoinbaseOrderBookProvider {
+	return &CoinbaseOrderBookProvider{
+		symbol:     strings.ToUpper(strings.ReplaceAll(symbol, "/", "-")),
+		done:       make(chan struct{}),
+		orderbooks: make(chan orderbook.OrderBook, 100),
+		bids:       make(map[float64]float64),
+		asks:       make(map[float64]float64),
+	}
+}
+
+// GetSymbol returns the trading pair symbol
+func (p *CoinbaseOrderBookProvider) GetSymbol() string {
+	return p.symbol
+}
+
+// Connect establishes a connection to Coinbase's public WebSocket feed
+func (p *CoinbaseOrderBookProvider) Connect() error {
+	var err error
+	p.conn, _, err = websocket.DefaultDialer.Dial(coinbaseWsURL, nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to Coinbase WebSocket: %w", err)
+	}
+
+	sub := map[string]any{
+		"type":        "subscribe",
+		"product_ids": []string{p.symbol},
+		"channels":    []string{"level2"},
+	}
+	if err := p.conn.WriteJSON(sub); err != nil {
+		return fmt.Errorf("error subscribing to Coinbase level2 channel: %w", err)
+	}
+
+	return nil
+}
+
+// Disconnect closes the WebSocket connection
+func (p *CoinbaseOrderBookProvider) Disconnect() error {
+	close(p.done)
+	if p.tickerConn != nil {
+		p.tickerConn.Close()
+	}
+	if p.conn != nil {
+		return p.conn.Close()
+	}
+	return nil
+}
+
+// SubscribeOrderBook subscribes to order book updates
+func (p *CoinbaseOrderBookProvider) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	go p.listenForUpdates()
+	return p.orderbooks, nil
+}
+
+// SubscribeBookTicker subscribes to best bid/offer updates for the
+// provider's symbol via Coinbase's ticker channel, on its own connection
+// since it runs independently of the level2 stream opened by Connect.
+func (p *CoinbaseOrderBookProvider) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(coinbaseWsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Coinbase WebSocket: %w", err)
+	}
+	p.tickerConn = conn
+
+	sub := map[string]any{
+		"type":        "subscribe",
+		"product_ids": []string{p.symbol},
+		"channels":    []string{"ticker"},
+	}
+	if err := conn.WriteJSON(sub); err != nil {
+		return nil, fmt.Errorf("error subscribing to Coinbase ticker channel: %w", err)
+	}
+
+	tickers := make(chan orderbook.BookTicker, 100)
+	go p.listenForBookTicker(conn, tickers)
+	return tickers, nil
+}
+
+// listenForUpdates listens for WebSocket messages and processes them
+func (p *CoinbaseOrderBookProvider) listenForUpdates() {
+	defer close(p.orderbooks)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := p.conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var msg coinbaseMessage
+			if err := json.Unmarshal(message, &msg); err != nil {
+				continue
+			}
+
+			switch msg.Type {
+			case "snapshot":
+				p.applySnapshot(msg)
+			case "l2update":
+				p.applyUpdate(msg)
+			default:
+				continue
+			}
+
+			p.orderbooks <- p.buildOrderBook(msg.Time)
+		}
+	}
+}
+
+// applySnapshot replaces the in-memory book with the initial level2 snapshot
+func (p *CoinbaseOrderBookProvider) applySnapshot(msg coinbaseMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.bids = make(map[float64]float64, len(msg.Bids))
+	p.asks = make(map[float64]float64, len(msg.Asks))
+	for _, level := range msg.Bids {
+		setLevel(p.bids, level)
+	}
+	for _, level := range msg.Asks {
+		setLevel(p.asks, level)
+	}
+}
+
+// applyUpdate merges an l2update's per-level changes into the in-memory book
+func (p *CoinbaseOrderBookProvider) applyUpdate(msg coinbaseMessage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, change := range msg.Changes {
+		if len(change) != 3 {
+			continue
+		}
+		side, priceStr, sizeStr := change[0], change[1], change[2]
+
+		price, err := strconv.ParseFloat(priceStr, 64)
+		if err != nil {
+			continue
+		}
+		size, err := strconv.ParseFloat(sizeStr, 64)
+		if err != nil {
+			continue
+		}
+
+		levels := p.bids
+		if side == "sell" {
+			levels = p.asks
+		}
+		if size == 0 {
+			delete(levels, price)
+		} else {
+			levels[price] = size
+		}
+	}
+}
+
+// setLevel parses a [price, size] pair from a snapshot message into levels
+func setLevel(levels map[float64]float64, level []string) {
+	if len(level) != 2 {
+		return
+	}
+	price, err := strconv.ParseFloat(level[0], 64)
+	if err != nil {
+		return
+	}
+	size, err := strconv.ParseFloat(level[1], 64)
+	if err != nil {
+		return
+	}
+	levels[price] = size
+}
+
+// buildOrderBook snapshots the in-memory book into a sorted orderbook.OrderBook
+func (p *CoinbaseOrderBookProvider) buildOrderBook(timestamp string) orderbook.OrderBook {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ob := orderbook.OrderBook{
+		Symbol:     p.symbol,
+		LastUpdate: parseCoinbaseTime(timestamp),
+		Bids:       make([]orderbook.PriceLevel, 0, len(p.bids)),
+		Asks:       make([]orderbook.PriceLevel, 0, len(p.asks)),
+	}
+
+	for price, size := range p.bids {
+		ob.Bids = append(ob.Bids, orderbook.PriceLevel{Price: price, Quantity: size})
+	}
+	for price, size := range p.asks {
+		ob.Asks = append(ob.Asks, orderbook.PriceLevel{Price: price, Quantity: size})
+	}
+
+	sort.Slice(ob.Bids, func(i, j int) bool { return ob.Bids[i].Price > ob.Bids[j].Price })
+	sort.Slice(ob.Asks, func(i, j int) bool { return ob.Asks[i].Price < ob.Asks[j].Price })
+
+	return ob
+}
+
+// parseCoinbaseTime parses a Coinbase RFC3339 timestamp, falling back to now on error
+func parseCoinbaseTime(timestamp string) time.Time {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Now().UTC()
+	}
+	return t
+}
+
+// listenForBookTicker listens for ticker-channel WebSocket messages and processes them
+func (p *CoinbaseOrderBookProvider) listenForBookTicker(conn *websocket.Conn, tickers chan<- orderbook.BookTicker) {
+	defer close(tickers)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var msg coinbaseTickerMessage
+			if err := json.Unmarshal(message, &msg); err != nil {
+				continue
+			}
+			if msg.Type != "ticker" || msg.BestBid == "" || msg.BestAsk == "" {
+				continue
+			}
+
+			bt, err := parseBookTicker(msg)
+			if err != nil {
+				continue
+			}
+
+			tickers <- bt
+		}
+	}
+}
+
+// parseBookTicker converts a coinbaseTickerMessage into an orderbook.BookTicker
+func parseBookTicker(msg coinbaseTickerMessage) (orderbook.BookTicker, error) {
+	bidPrice, err := strconv.ParseFloat(msg.BestBid, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	bidQty, err := strconv.ParseFloat(msg.BestBidSize, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askPrice, err := strconv.ParseFloat(msg.BestAsk, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askQty, err := strconv.ParseFloat(msg.BestAskSize, 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+
+	return orderbook.BookTicker{
+		Symbol:    msg.ProductID,
+		BidPrice:  bidPrice,
+		BidQty:    bidQty,
+		AskPrice:  askPrice,
+		AskQty:    askQty,
+		UpdateID:  msg.Sequence,
+		Timestamp: parseCoinbaseTime(msg.Time),
+	}, nil
+}
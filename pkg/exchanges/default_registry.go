@@ -0,0 +1,45 @@
+package exchanges
+
+import (
+	"github.com/VladKochetov007/lob_view/pkg/exchanges/binance"
+	"github.com/VladKochetov007/lob_view/pkg/exchanges/bybit"
+	"github.com/VladKochetov007/lob_view/pkg/exchanges/coinbase"
+	"github.com/VladKochetov007/lob_view/pkg/exchanges/kraken"
+	"github.com/VladKochetov007/lob_view/pkg/exchanges/okx"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+	"github.com/VladKochetov007/lob_view/pkg/orderbook/reconnect"
+)
+
+// NewDefaultRegistry builds the registry of built-in exchange providers,
+// each wrapped in reconnect.Source so a dropped WebSocket connection is
+// retried with backoff and a circuit breaker instead of silently killing
+// the stream
+func NewDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	registry.Register("binance", reconnecting(func(symbol string) orderbook.OrderBookSource {
+		return binance.NewBinanceOrderBookProvider(symbol)
+	}))
+	registry.Register("bybit", reconnecting(func(symbol string) orderbook.OrderBookSource {
+		return bybit.NewBybitOrderBookProvider(symbol)
+	}))
+	registry.Register("okx", reconnecting(func(symbol string) orderbook.OrderBookSource {
+		return okx.NewOKXOrderBookProvider(symbol)
+	}))
+	registry.Register("coinbase", reconnecting(func(symbol string) orderbook.OrderBookSource {
+		return coinbase.NewCoinbaseOrderBookProvider(symbol)
+	}))
+	registry.Register("kraken", reconnecting(func(symbol string) orderbook.OrderBookSource {
+		return kraken.NewKrakenOrderBookProvider(symbol)
+	}))
+	return registry
+}
+
+// reconnecting wraps factory so every provider it builds auto-reconnects on
+// connection drops with exponential backoff and a circuit breaker
+func reconnecting(factory Factory) Factory {
+	return func(symbol string) orderbook.OrderBookSource {
+		return reconnect.NewSource(symbol, func() orderbook.OrderBookSource {
+			return factory(symbol)
+		}, reconnect.DefaultCircuitBreakerConfig())
+	}
+}
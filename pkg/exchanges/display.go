@@ -3,6 +3,7 @@ package exchanges
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/VladKochetov007/lob_view/pkg/orderbook"
@@ -107,17 +108,129 @@ func DisplayOrderBook(ob orderbook.OrderBook, depth int) {
 func DisplayOrderBookContinuously(updates <-chan orderbook.OrderBook, depth int) {
 	// Устанавливаем минимальный размер ордербука для отображения
 	const minLevels = 5 // Должно быть как минимум 5 уровней для отображения
-	
+
 	for ob := range updates {
 		// Строгая проверка на полные данные
 		if len(ob.Bids) < minLevels || len(ob.Asks) < minLevels {
 			continue // Пропускаем неполные данные
 		}
-		
+
 		// Очищаем терминал перед каждым выводом
 		fmt.Print("\033[H\033[2J")
-		
+
 		// Выводим только один полный ордербук
 		DisplayOrderBook(ob, depth)
 	}
-} 
\ No newline at end of file
+}
+
+// DisplayMergedOrderBooksContinuously renders order books from multiple exchanges
+// on one screen, refreshing whenever any exchange emits an update. Each exchange's
+// most recent book is kept and redrawn together so the user can compare them.
+// The books are printed one below another, in the order the exchanges first
+// delivered a book; they are not laid out in columns.
+func DisplayMergedOrderBooksContinuously(events <-chan OrderBookEvent, depth int) {
+	const minLevels = 5 // Должно быть как минимум 5 уровней для отображения
+
+	latest := make(map[string]orderbook.OrderBook)
+	order := make([]string, 0)
+
+	for event := range events {
+		if len(event.OrderBook.Bids) < minLevels || len(event.OrderBook.Asks) < minLevels {
+			continue // Пропускаем неполные данные
+		}
+
+		if _, seen := latest[event.Exchange]; !seen {
+			order = append(order, event.Exchange)
+		}
+		latest[event.Exchange] = event.OrderBook
+
+		// Очищаем терминал перед каждым выводом
+		fmt.Print("\033[H\033[2J")
+
+		for _, exchange := range order {
+			fmt.Printf("%s%s[%s]%s\n", Bold, Yellow, exchange, Reset)
+			DisplayOrderBook(latest[exchange], depth)
+		}
+	}
+}
+
+// DisplayBookTickerContinuously renders a compact one-line top-of-book view,
+// for users who only need the best bid/ask rather than full depth.
+func DisplayBookTickerContinuously(tickers <-chan orderbook.BookTicker) {
+	for bt := range tickers {
+		spread := bt.AskPrice - bt.BidPrice
+		fmt.Printf("%s%-12s%s bid %s%-12.8f%s x %-12.8f ask %s%-12.8f%s x %-12.8f spread %.8f\n",
+			Bold, bt.Symbol, Reset,
+			Green, bt.BidPrice, Reset, bt.BidQty,
+			Red, bt.AskPrice, Reset, bt.AskQty,
+			spread)
+	}
+}
+
+// DisplayMiniTickerScanContinuously renders a scrolling table of the topN
+// gainers and topN losers (by 24h percent change) out of each mini-ticker
+// batch received from an all-market stream.
+func DisplayMiniTickerScanContinuously(tickers <-chan []orderbook.MiniTicker, topN int) {
+	if topN <= 0 {
+		topN = 10
+	}
+
+	for batch := range tickers {
+		ranked := rankByPercentChange(batch)
+		if len(ranked) == 0 {
+			continue
+		}
+
+		// Очищаем терминал перед каждым выводом
+		fmt.Print("\033[H\033[2J")
+
+		n := topN
+		if n > len(ranked) {
+			n = len(ranked)
+		}
+
+		fmt.Printf("%s%sTop %d Gainers%s\n", Bold, Green, n, Reset)
+		gainers := ranked[len(ranked)-n:]
+		for i := len(gainers) - 1; i >= 0; i-- {
+			printMiniTickerRow(gainers[i])
+		}
+
+		fmt.Printf("\n%s%sTop %d Losers%s\n", Bold, Red, n, Reset)
+		for _, t := range ranked[:n] {
+			printMiniTickerRow(t)
+		}
+	}
+}
+
+// rankByPercentChange returns tickers sorted ascending by 24h percent change,
+// skipping any symbol with a zero open price
+func rankByPercentChange(tickers []orderbook.MiniTicker) []orderbook.MiniTicker {
+	ranked := make([]orderbook.MiniTicker, 0, len(tickers))
+	for _, t := range tickers {
+		if t.Open == 0 {
+			continue
+		}
+		ranked = append(ranked, t)
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		return percentChange(ranked[i]) < percentChange(ranked[j])
+	})
+
+	return ranked
+}
+
+// percentChange returns a MiniTicker's 24h percent change
+func percentChange(t orderbook.MiniTicker) float64 {
+	return (t.Close - t.Open) / t.Open * 100
+}
+
+// printMiniTickerRow prints a single scan-table row for t
+func printMiniTickerRow(t orderbook.MiniTicker) {
+	change := percentChange(t)
+	color := Green
+	if change < 0 {
+		color = Red
+	}
+	fmt.Printf("%-12s %s%+7.2f%%%s  last %-12.8f  vol %.2f\n", t.Symbol, color, change, Reset, t.Close, t.Volume)
+}
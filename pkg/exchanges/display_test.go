@@ -0,0 +1,96 @@
+package exchanges
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// captureStdout redirects os.Stdout for the duration of fn and returns
+// everything written to it
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	old := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+
+	fn()
+
+	w.Close()
+	os.Stdout = old
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("io.Copy: %v", err)
+	}
+	return buf.String()
+}
+
+func TestRankByPercentChangeOrdersAscending(t *testing.T) {
+	batch := []orderbook.MiniTicker{
+		{Symbol: "BBB", Open: 100, Close: 120}, // +20%
+		{Symbol: "AAA", Open: 100, Close: 90},  // -10%
+		{Symbol: "CCC", Open: 100, Close: 105}, // +5%
+	}
+
+	ranked := rankByPercentChange(batch)
+
+	want := []string{"AAA", "CCC", "BBB"}
+	if len(ranked) != len(want) {
+		t.Fatalf("got %d ranked tickers, want %d", len(ranked), len(want))
+	}
+	for i, symbol := range want {
+		if ranked[i].Symbol != symbol {
+			t.Errorf("rank %d: got %s, want %s", i, ranked[i].Symbol, symbol)
+		}
+	}
+}
+
+// TestDisplayMiniTickerScanContinuouslyPutsHighestChangeInGainersAndLowestInLosers
+// guards against the gainers/losers sections being swapped, which shipped
+// once already and was only caught after the fact.
+func TestDisplayMiniTickerScanContinuouslyPutsHighestChangeInGainersAndLowestInLosers(t *testing.T) {
+	batch := []orderbook.MiniTicker{
+		{Symbol: "AAA", Open: 100, Close: 90},  // -10%, the biggest loser
+		{Symbol: "BBB", Open: 100, Close: 120}, // +20%, the biggest gainer
+		{Symbol: "CCC", Open: 100, Close: 101}, // +1%, neither
+	}
+
+	tickers := make(chan []orderbook.MiniTicker, 1)
+	tickers <- batch
+	close(tickers)
+
+	output := captureStdout(t, func() {
+		DisplayMiniTickerScanContinuously(tickers, 1)
+	})
+
+	gainersIdx := strings.Index(output, "Gainers")
+	losersIdx := strings.Index(output, "Losers")
+	if gainersIdx == -1 || losersIdx == -1 || gainersIdx > losersIdx {
+		t.Fatalf("expected a Gainers section before a Losers section, got:\n%s", output)
+	}
+
+	gainersSection := output[gainersIdx:losersIdx]
+	losersSection := output[losersIdx:]
+
+	if !strings.Contains(gainersSection, "BBB") {
+		t.Errorf("expected the biggest gainer BBB in the Gainers section, got:\n%s", gainersSection)
+	}
+	if !strings.Contains(losersSection, "AAA") {
+		t.Errorf("expected the biggest loser AAA in the Losers section, got:\n%s", losersSection)
+	}
+	if strings.Contains(gainersSection, "AAA") {
+		t.Errorf("did not expect the biggest loser AAA in the Gainers section, got:\n%s", gainersSection)
+	}
+	if strings.Contains(losersSection, "BBB") {
+		t.Errorf("did not expect the biggest gainer BBB in the Losers section, got:\n%s", losersSection)
+	}
+}
@@ -0,0 +1,294 @@
+// Package kraken provides implementation of OrderBookSource for the Kraken exchange
+package kraken
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	krakenWsURL = "wss://ws.kraken.com"
+	krakenDepth = 10
+)
+
+// KrakenOrderBookProvider implements the OrderBookSource interface for Kraken.
+// Kraken's book channel sends an initial snapshot followed by incremental
+// updates, so the provider keeps the running book in memory and re-emits the
+// full book on every update.
+type KrakenOrderBookProvider struct {
+	symbol     string
+	conn       *websocket.Conn
+	spreadConn *websocket.Conn
+	done       chan struct{}
+	orderbooks chan orderbook.OrderBook
+
+	mu   sync.Mutex
+	bids map[float64]float64
+	asks map[float64]float64
+}
+
+// krakenBookData carries the bid/ask level arrays of a snapshot or update message
+type krakenBookData struct {
+	Bids     [][]string `json:"b"`
+	BidsSnap [][]string `json:"bs"`
+	Asks     [][]string `json:"a"`
+	AsksSnap [][]string `json:"as"`
+}
+
+// NewKrakenOrderBookProvider creates a new Kraken order book provider
+func NewKrakenOrderBookProvider(symbol string) *KrakenOrderBookProvider {
+	return &KrakenOrderBookProvider{
+		symbol:     strings.ToUpper(symbol),
+		done:       make(chan struct{}),
+		orderbooks: make(chan orderbook.OrderBook, 100),
+		bids:       make(map[float64]float64),
+		asks:       make(map[float64]float64),
+	}
+}
+
+// GetSymbol returns the trading pair symbol
+func (p *KrakenOrderBookProvider) GetSymbol() string {
+	return p.symbol
+}
+
+// Connect establishes a connection to Kraken's public WebSocket API
+func (p *KrakenOrderBookProvider) Connect() error {
+	var err error
+	p.conn, _, err = websocket.DefaultDialer.Dial(krakenWsURL, nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to Kraken WebSocket: %w", err)
+	}
+
+	sub := map[string]any{
+		"event": "subscribe",
+		"pair":  []string{p.symbol},
+		"subscription": map[string]any{
+			"name":  "book",
+			"depth": krakenDepth,
+		},
+	}
+	if err := p.conn.WriteJSON(sub); err != nil {
+		return fmt.Errorf("error subscribing to Kraken book channel: %w", err)
+	}
+
+	return nil
+}
+
+// Disconnect closes the WebSocket connection
+func (p *KrakenOrderBookProvider) Disconnect() error {
+	close(p.done)
+	if p.spreadConn != nil {
+		p.spreadConn.Close()
+	}
+	if p.conn != nil {
+		return p.conn.Close()
+	}
+	return nil
+}
+
+// SubscribeOrderBook subscribes to order book updates
+func (p *KrakenOrderBookProvider) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	go p.listenForUpdates()
+	return p.orderbooks, nil
+}
+
+// SubscribeBookTicker subscribes to best bid/offer updates for the
+// provider's symbol via Kraken's "spread" channel, on its own connection
+// since it runs independently of the book stream opened by Connect.
+func (p *KrakenOrderBookProvider) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(krakenWsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Kraken WebSocket: %w", err)
+	}
+	p.spreadConn = conn
+
+	sub := map[string]any{
+		"event": "subscribe",
+		"pair":  []string{p.symbol},
+		"subscription": map[string]any{
+			"name": "spread",
+		},
+	}
+	if err := conn.WriteJSON(sub); err != nil {
+		return nil, fmt.Errorf("error subscribing to Kraken spread channel: %w", err)
+	}
+
+	tickers := make(chan orderbook.BookTicker, 100)
+	go p.listenForBookTicker(conn, tickers)
+	return tickers, nil
+}
+
+// listenForUpdates listens for WebSocket messages and processes them.
+// Kraken's book channel frames arrive as a JSON array: [channelID, data, channelName, pair],
+// distinct from the JSON objects used for control/event messages.
+func (p *KrakenOrderBookProvider) listenForUpdates() {
+	defer close(p.orderbooks)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := p.conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var frame []json.RawMessage
+			if err := json.Unmarshal(message, &frame); err != nil {
+				// Not an array frame - an event/control message, ignore it
+				continue
+			}
+			if len(frame) < 2 {
+				continue
+			}
+
+			var data krakenBookData
+			if err := json.Unmarshal(frame[1], &data); err != nil {
+				continue
+			}
+
+			p.applyUpdate(data)
+			p.orderbooks <- p.buildOrderBook()
+		}
+	}
+}
+
+// applyUpdate merges a snapshot's or update's bid/ask levels into the in-memory book
+func (p *KrakenOrderBookProvider) applyUpdate(data krakenBookData) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, level := range append(data.BidsSnap, data.Bids...) {
+		setLevel(p.bids, level)
+	}
+	for _, level := range append(data.AsksSnap, data.Asks...) {
+		setLevel(p.asks, level)
+	}
+}
+
+// setLevel parses a [price, volume, timestamp] triple into levels, removing zero-volume levels
+func setLevel(levels map[float64]float64, level []string) {
+	if len(level) < 2 {
+		return
+	}
+	price, err := strconv.ParseFloat(level[0], 64)
+	if err != nil {
+		return
+	}
+	volume, err := strconv.ParseFloat(level[1], 64)
+	if err != nil {
+		return
+	}
+	if volume == 0 {
+		delete(levels, price)
+	} else {
+		levels[price] = volume
+	}
+}
+
+// buildOrderBook snapshots the in-memory book into a sorted orderbook.OrderBook
+func (p *KrakenOrderBookProvider) buildOrderBook() orderbook.OrderBook {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ob := orderbook.OrderBook{
+		Symbol:     p.symbol,
+		LastUpdate: time.Now().UTC(),
+		Bids:       make([]orderbook.PriceLevel, 0, len(p.bids)),
+		Asks:       make([]orderbook.PriceLevel, 0, len(p.asks)),
+	}
+
+	for price, volume := range p.bids {
+		ob.Bids = append(ob.Bids, orderbook.PriceLevel{Price: price, Quantity: volume})
+	}
+	for price, volume := range p.asks {
+		ob.Asks = append(ob.Asks, orderbook.PriceLevel{Price: price, Quantity: volume})
+	}
+
+	sort.Slice(ob.Bids, func(i, j int) bool { return ob.Bids[i].Price > ob.Bids[j].Price })
+	sort.Slice(ob.Asks, func(i, j int) bool { return ob.Asks[i].Price < ob.Asks[j].Price })
+
+	return ob
+}
+
+// listenForBookTicker listens for spread-channel WebSocket messages and processes them.
+// Frames arrive as [channelID, [bid, ask, timestamp, bidVolume, askVolume], "spread", pair].
+func (p *KrakenOrderBookProvider) listenForBookTicker(conn *websocket.Conn, tickers chan<- orderbook.BookTicker) {
+	defer close(tickers)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var frame []json.RawMessage
+			if err := json.Unmarshal(message, &frame); err != nil {
+				continue
+			}
+			if len(frame) < 2 {
+				continue
+			}
+
+			var data [5]string
+			if err := json.Unmarshal(frame[1], &data); err != nil {
+				continue
+			}
+
+			bt, err := parseBookTicker(p.symbol, data)
+			if err != nil {
+				continue
+			}
+
+			tickers <- bt
+		}
+	}
+}
+
+// parseBookTicker converts a Kraken spread-channel payload into an orderbook.BookTicker
+func parseBookTicker(symbol string, data [5]string) (orderbook.BookTicker, error) {
+	bidPrice, err := strconv.ParseFloat(data[0], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askPrice, err := strconv.ParseFloat(data[1], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	timestamp, err := strconv.ParseFloat(data[2], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	bidQty, err := strconv.ParseFloat(data[3], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askQty, err := strconv.ParseFloat(data[4], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+
+	return orderbook.BookTicker{
+		Symbol:    symbol,
+		BidPrice:  bidPrice,
+		BidQty:    bidQty,
+		AskPrice:  askPrice,
+		AskQty:    askQty,
+		Timestamp: time.Unix(0, int64(timestamp*float64(time.Second))),
+	}, nil
+}
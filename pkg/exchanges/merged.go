@@ -0,0 +1,75 @@
+package exchanges
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// OrderBookEvent is an order book update tagged with the exchange it came from
+type OrderBookEvent struct {
+	Exchange  string
+	Symbol    string
+	OrderBook orderbook.OrderBook
+}
+
+// MergedSource fans in order book updates from multiple named OrderBookSources
+// into a single channel of OrderBookEvent
+type MergedSource struct {
+	sources map[string]orderbook.OrderBookSource
+}
+
+// NewMergedSource creates a MergedSource over the given exchange-name -> source map
+func NewMergedSource(sources map[string]orderbook.OrderBookSource) *MergedSource {
+	return &MergedSource{sources: sources}
+}
+
+// Connect connects every underlying source, returning the first error encountered
+func (m *MergedSource) Connect() error {
+	for name, src := range m.sources {
+		if err := src.Connect(); err != nil {
+			return fmt.Errorf("connect %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Disconnect disconnects every underlying source, returning the first error encountered
+func (m *MergedSource) Disconnect() error {
+	var firstErr error
+	for name, src := range m.sources {
+		if err := src.Disconnect(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("disconnect %s: %w", name, err)
+		}
+	}
+	return firstErr
+}
+
+// Subscribe fans in order book updates from every underlying source into one channel
+func (m *MergedSource) Subscribe() (<-chan OrderBookEvent, error) {
+	out := make(chan OrderBookEvent, 100*len(m.sources))
+
+	var wg sync.WaitGroup
+	for name, src := range m.sources {
+		updates, err := src.SubscribeOrderBook()
+		if err != nil {
+			return nil, fmt.Errorf("subscribe %s: %w", name, err)
+		}
+
+		wg.Add(1)
+		go func(exchange string, updates <-chan orderbook.OrderBook) {
+			defer wg.Done()
+			for ob := range updates {
+				out <- OrderBookEvent{Exchange: exchange, Symbol: ob.Symbol, OrderBook: ob}
+			}
+		}(name, updates)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out, nil
+}
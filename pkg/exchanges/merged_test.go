@@ -0,0 +1,78 @@
+package exchanges
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// fakeSource is a minimal orderbook.OrderBookSource that replays a fixed
+// sequence of order books and then closes, for testing fan-in behavior
+// without a real exchange connection.
+type fakeSource struct {
+	symbol string
+	books  chan orderbook.OrderBook
+}
+
+func newFakeSource(symbol string, books ...orderbook.OrderBook) *fakeSource {
+	ch := make(chan orderbook.OrderBook, len(books))
+	for _, ob := range books {
+		ch <- ob
+	}
+	close(ch)
+	return &fakeSource{symbol: symbol, books: ch}
+}
+
+func (f *fakeSource) GetSymbol() string { return f.symbol }
+func (f *fakeSource) Connect() error    { return nil }
+func (f *fakeSource) Disconnect() error { return nil }
+
+func (f *fakeSource) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	return f.books, nil
+}
+
+func (f *fakeSource) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	return nil, nil
+}
+
+func TestMergedSourceSubscribeFansInFromEverySource(t *testing.T) {
+	sources := map[string]orderbook.OrderBookSource{
+		"binance": newFakeSource("BTCUSDT", orderbook.OrderBook{Symbol: "BTCUSDT"}),
+		"bybit":   newFakeSource("BTCUSDT", orderbook.OrderBook{Symbol: "BTCUSDT"}),
+	}
+	merged := NewMergedSource(sources)
+
+	events, err := merged.Subscribe()
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < len(sources); i++ {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("events closed early after %d events", i)
+			}
+			seen[event.Exchange] = true
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for merged event %d", i)
+		}
+	}
+
+	for name := range sources {
+		if !seen[name] {
+			t.Errorf("expected a merged event tagged with exchange %q", name)
+		}
+	}
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("expected events to close once every source's channel closes")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for events to close")
+	}
+}
@@ -0,0 +1,263 @@
+// Package okx provides implementation of OrderBookSource for the OKX exchange
+package okx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	okxWsURL = "wss://ws.okx.com:8443/ws/v5/public"
+)
+
+// OKXOrderBookProvider implements the OrderBookSource interface for OKX
+type OKXOrderBookProvider struct {
+	symbol     string
+	conn       *websocket.Conn
+	bboConn    *websocket.Conn
+	done       chan struct{}
+	orderbooks chan orderbook.OrderBook
+}
+
+// okxOrderBookMessage represents a message from OKX's books5 channel
+type okxOrderBookMessage struct {
+	Arg struct {
+		Channel string `json:"channel"`
+		InstID  string `json:"instId"`
+	} `json:"arg"`
+	Data []struct {
+		Asks [][2]string `json:"asks"`
+		Bids [][2]string `json:"bids"`
+		Ts   string      `json:"ts"`
+	} `json:"data"`
+}
+
+// NewOKXOrderBookProvider creates a new OKX order book provider
+func NewOKXOrderBookProvider(symbol string) *OKXOrderBookProvider {
+	return &OKXOrderBookProvider{
+		symbol:     strings.ToUpper(strings.ReplaceAll(symbol, "/", "-")),
+		done:       make(chan struct{}),
+		orderbooks: make(chan orderbook.OrderBook, 100),
+	}
+}
+
+// GetSymbol returns the trading pair symbol
+func (p *OKXOrderBookProvider) GetSymbol() string {
+	return p.symbol
+}
+
+// Connect establishes a connection to OKX's public WebSocket API
+func (p *OKXOrderBookProvider) Connect() error {
+	var err error
+	p.conn, _, err = websocket.DefaultDialer.Dial(okxWsURL, nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to OKX WebSocket: %w", err)
+	}
+
+	sub := map[string]any{
+		"op": "subscribe",
+		"args": []map[string]string{
+			{"channel": "books5", "instId": p.symbol},
+		},
+	}
+	if err := p.conn.WriteJSON(sub); err != nil {
+		return fmt.Errorf("error subscribing to OKX books5 channel: %w", err)
+	}
+
+	return nil
+}
+
+// Disconnect closes the WebSocket connection
+func (p *OKXOrderBookProvider) Disconnect() error {
+	close(p.done)
+	if p.bboConn != nil {
+		p.bboConn.Close()
+	}
+	if p.conn != nil {
+		return p.conn.Close()
+	}
+	return nil
+}
+
+// SubscribeOrderBook subscribes to order book updates
+func (p *OKXOrderBookProvider) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	go p.listenForUpdates()
+	return p.orderbooks, nil
+}
+
+// SubscribeBookTicker subscribes to best bid/offer updates for the
+// provider's symbol via OKX's tick-by-tick "bbo-tbt" channel, on its own
+// connection since it runs independently of the books5 stream opened by Connect.
+func (p *OKXOrderBookProvider) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(okxWsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to OKX WebSocket: %w", err)
+	}
+	p.bboConn = conn
+
+	sub := map[string]any{
+		"op": "subscribe",
+		"args": []map[string]string{
+			{"channel": "bbo-tbt", "instId": p.symbol},
+		},
+	}
+	if err := conn.WriteJSON(sub); err != nil {
+		return nil, fmt.Errorf("error subscribing to OKX bbo-tbt channel: %w", err)
+	}
+
+	tickers := make(chan orderbook.BookTicker, 100)
+	go p.listenForBookTicker(conn, tickers)
+	return tickers, nil
+}
+
+// listenForUpdates listens for WebSocket messages and processes them
+func (p *OKXOrderBookProvider) listenForUpdates() {
+	defer close(p.orderbooks)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := p.conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var msg okxOrderBookMessage
+			if err := json.Unmarshal(message, &msg); err != nil {
+				continue
+			}
+			if msg.Arg.Channel != "books5" || len(msg.Data) == 0 {
+				continue
+			}
+
+			p.processOrderBookUpdate(msg)
+		}
+	}
+}
+
+// processOrderBookUpdate processes an order book update from OKX
+func (p *OKXOrderBookProvider) processOrderBookUpdate(msg okxOrderBookMessage) {
+	snapshot := msg.Data[0]
+
+	timestampMs, err := strconv.ParseInt(snapshot.Ts, 10, 64)
+	if err != nil {
+		timestampMs = time.Now().UnixMilli()
+	}
+
+	ob := orderbook.OrderBook{
+		Symbol:     p.symbol,
+		LastUpdate: time.Unix(0, timestampMs*int64(time.Millisecond)),
+		Bids:       make([]orderbook.PriceLevel, 0, len(snapshot.Bids)),
+		Asks:       make([]orderbook.PriceLevel, 0, len(snapshot.Asks)),
+	}
+
+	for _, bid := range snapshot.Bids {
+		price, err := strconv.ParseFloat(bid[0], 64)
+		if err != nil {
+			continue
+		}
+		quantity, err := strconv.ParseFloat(bid[1], 64)
+		if err != nil {
+			continue
+		}
+		ob.Bids = append(ob.Bids, orderbook.PriceLevel{Price: price, Quantity: quantity})
+	}
+
+	for _, ask := range snapshot.Asks {
+		price, err := strconv.ParseFloat(ask[0], 64)
+		if err != nil {
+			continue
+		}
+		quantity, err := strconv.ParseFloat(ask[1], 64)
+		if err != nil {
+			continue
+		}
+		ob.Asks = append(ob.Asks, orderbook.PriceLevel{Price: price, Quantity: quantity})
+	}
+
+	p.orderbooks <- ob
+}
+
+// listenForBookTicker listens for bbo-tbt WebSocket messages and processes them
+func (p *OKXOrderBookProvider) listenForBookTicker(conn *websocket.Conn, tickers chan<- orderbook.BookTicker) {
+	defer close(tickers)
+
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				fmt.Printf("Error reading message: %v\n", err)
+				return
+			}
+
+			var msg okxOrderBookMessage
+			if err := json.Unmarshal(message, &msg); err != nil {
+				continue
+			}
+			if msg.Arg.Channel != "bbo-tbt" || len(msg.Data) == 0 {
+				continue
+			}
+
+			bt, err := parseBookTicker(p.symbol, msg.Data[0])
+			if err != nil {
+				continue
+			}
+
+			tickers <- bt
+		}
+	}
+}
+
+// parseBookTicker converts an OKX bbo-tbt data entry into an orderbook.BookTicker
+func parseBookTicker(symbol string, snapshot struct {
+	Asks [][2]string `json:"asks"`
+	Bids [][2]string `json:"bids"`
+	Ts   string      `json:"ts"`
+}) (orderbook.BookTicker, error) {
+	if len(snapshot.Bids) == 0 || len(snapshot.Asks) == 0 {
+		return orderbook.BookTicker{}, fmt.Errorf("bbo-tbt message missing bid or ask level")
+	}
+
+	bidPrice, err := strconv.ParseFloat(snapshot.Bids[0][0], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	bidQty, err := strconv.ParseFloat(snapshot.Bids[0][1], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askPrice, err := strconv.ParseFloat(snapshot.Asks[0][0], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+	askQty, err := strconv.ParseFloat(snapshot.Asks[0][1], 64)
+	if err != nil {
+		return orderbook.BookTicker{}, err
+	}
+
+	timestampMs, err := strconv.ParseInt(snapshot.Ts, 10, 64)
+	if err != nil {
+		timestampMs = time.Now().UnixMilli()
+	}
+
+	return orderbook.BookTicker{
+		Symbol:    symbol,
+		BidPrice:  bidPrice,
+		BidQty:    bidQty,
+		AskPrice:  askPrice,
+		AskQty:    askQty,
+		Timestamp: time.Unix(0, timestampMs*int64(time.Millisecond)),
+	}, nil
+}
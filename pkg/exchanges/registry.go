@@ -0,0 +1,51 @@
+package exchanges
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// Factory builds an OrderBookSource for the given trading pair symbol
+type Factory func(symbol string) orderbook.OrderBookSource
+
+// Registry maps an exchange name to the Factory that builds its OrderBookSource
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry creates an empty exchange registry
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register associates an exchange name with a Factory
+func (r *Registry) Register(name string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[name] = factory
+}
+
+// New builds an OrderBookSource for the named exchange and symbol
+func (r *Registry) New(name, symbol string) (orderbook.OrderBookSource, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown exchange: %s", name)
+	}
+	return factory(symbol), nil
+}
+
+// Names returns the exchange names currently registered
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	return names
+}
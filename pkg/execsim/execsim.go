@@ -0,0 +1,55 @@
+// Package execsim simulates TWAP/VWAP parent-order execution against a live
+// order book, so strategies can be researched against real depth without
+// sending real orders.
+package execsim
+
+import (
+	"time"
+)
+
+// Side is the direction of the simulated parent order
+type Side string
+
+const (
+	Buy  Side = "buy"
+	Sell Side = "sell"
+)
+
+// Params configures a single TWAP simulation run. The parent order of size
+// TotalQty is worked evenly over Duration, in child slices spaced
+// SliceInterval apart. PriceLimit of zero disables the limit check.
+type Params struct {
+	Symbol        string
+	Side          Side
+	TotalQty      float64
+	Duration      time.Duration
+	SliceInterval time.Duration
+	PriceLimit    float64
+}
+
+// sliceQty is the size of each child slice, TotalQty * SliceInterval / Duration.
+// sliceCount of them sum to TotalQty when Duration is a whole multiple of
+// SliceInterval; otherwise they sum to less.
+func (p Params) sliceQty() float64 {
+	return p.TotalQty * p.SliceInterval.Seconds() / p.Duration.Seconds()
+}
+
+// sliceCount is the number of child slices the parent order is worked over
+func (p Params) sliceCount() int {
+	return int(p.Duration / p.SliceInterval)
+}
+
+// SliceReport is emitted once per child slice after it has been worked
+// against the current book
+type SliceReport struct {
+	SliceIndex       int
+	Timestamp        time.Time
+	RequestedQty     float64
+	FilledQty        float64
+	FillPrice        float64
+	CumulativeFilled float64
+	CumulativeVWAP   float64
+	ArrivalMidPrice  float64
+	SlippageBps      float64
+	ResidualQty      float64
+}
@@ -0,0 +1,170 @@
+package execsim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// Run simulates TWAP execution of params against provider's live order
+// book, emitting one SliceReport per child slice until the parent order is
+// fully filled or its duration elapses. provider must already be connected.
+func Run(ctx context.Context, provider orderbook.OrderBookSource, params Params) (<-chan SliceReport, error) {
+	if params.TotalQty <= 0 {
+		return nil, fmt.Errorf("execsim: total quantity must be positive")
+	}
+	if params.Duration <= 0 || params.SliceInterval <= 0 || params.SliceInterval > params.Duration {
+		return nil, fmt.Errorf("execsim: slice interval must be positive and no greater than duration")
+	}
+	if params.Side != Buy && params.Side != Sell {
+		return nil, fmt.Errorf("execsim: side must be %q or %q, got %q", Buy, Sell, params.Side)
+	}
+
+	updates, err := provider.SubscribeOrderBook()
+	if err != nil {
+		return nil, fmt.Errorf("execsim: subscribe order book: %w", err)
+	}
+
+	reports := make(chan SliceReport, params.sliceCount()+1)
+	go run(ctx, updates, params, reports)
+
+	return reports, nil
+}
+
+func run(ctx context.Context, updates <-chan orderbook.OrderBook, params Params, reports chan<- SliceReport) {
+	defer close(reports)
+
+	var latest orderbook.OrderBook
+	haveBook := false
+
+	select {
+	case ob, ok := <-updates:
+		if !ok {
+			return
+		}
+		latest = ob
+		haveBook = true
+	case <-ctx.Done():
+		return
+	}
+
+	arrivalMid := midPrice(latest)
+
+	ticker := time.NewTicker(params.SliceInterval)
+	defer ticker.Stop()
+
+	sliceQty := params.sliceQty()
+	totalSlices := params.sliceCount()
+	residual := params.TotalQty
+	var cumulativeFilled, cumulativeNotional float64
+
+	for slice := 1; slice <= totalSlices && residual > 0; {
+		select {
+		case <-ctx.Done():
+			return
+		case ob, ok := <-updates:
+			if !ok {
+				return
+			}
+			latest = ob
+			haveBook = true
+		case t := <-ticker.C:
+			if !haveBook {
+				continue
+			}
+
+			requested := sliceQty
+			if requested > residual {
+				requested = residual
+			}
+
+			filledQty, notional := walkBook(latest, params.Side, requested, params.PriceLimit)
+
+			residual -= filledQty
+			cumulativeFilled += filledQty
+			cumulativeNotional += notional
+
+			var fillPrice float64
+			if filledQty > 0 {
+				fillPrice = notional / filledQty
+			}
+			var cumulativeVWAP float64
+			if cumulativeFilled > 0 {
+				cumulativeVWAP = cumulativeNotional / cumulativeFilled
+			}
+
+			reports <- SliceReport{
+				SliceIndex:       slice,
+				Timestamp:        t,
+				RequestedQty:     requested,
+				FilledQty:        filledQty,
+				FillPrice:        fillPrice,
+				CumulativeFilled: cumulativeFilled,
+				CumulativeVWAP:   cumulativeVWAP,
+				ArrivalMidPrice:  arrivalMid,
+				SlippageBps:      slippageBps(params.Side, cumulativeVWAP, arrivalMid),
+				ResidualQty:      residual,
+			}
+
+			slice++
+		}
+	}
+}
+
+// walkBook accumulates fills against ob's asks (for a Buy) or bids (for a
+// Sell), level by level, until qty is exhausted or priceLimit is crossed.
+// priceLimit of zero disables the check.
+func walkBook(ob orderbook.OrderBook, side Side, qty, priceLimit float64) (filledQty, notional float64) {
+	levels := ob.Asks
+	if side == Sell {
+		levels = ob.Bids
+	}
+
+	remaining := qty
+	for _, level := range levels {
+		if remaining <= 0 {
+			break
+		}
+		if priceLimit != 0 {
+			if side == Buy && level.Price > priceLimit {
+				break
+			}
+			if side == Sell && level.Price < priceLimit {
+				break
+			}
+		}
+
+		take := level.Quantity
+		if take > remaining {
+			take = remaining
+		}
+
+		filledQty += take
+		notional += take * level.Price
+		remaining -= take
+	}
+
+	return filledQty, notional
+}
+
+// midPrice returns the mid of ob's best bid and best ask, or zero if either side is empty
+func midPrice(ob orderbook.OrderBook) float64 {
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		return 0
+	}
+	return (ob.Bids[0].Price + ob.Asks[0].Price) / 2
+}
+
+// slippageBps returns execution slippage in basis points relative to the
+// arrival mid-price: positive means the fill was worse than the mid for side
+func slippageBps(side Side, vwap, arrivalMid float64) float64 {
+	if arrivalMid == 0 {
+		return 0
+	}
+	if side == Buy {
+		return (vwap - arrivalMid) / arrivalMid * 10000
+	}
+	return (arrivalMid - vwap) / arrivalMid * 10000
+}
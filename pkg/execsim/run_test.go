@@ -0,0 +1,78 @@
+package execsim
+
+import (
+	"testing"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+func testBook() orderbook.OrderBook {
+	return orderbook.OrderBook{
+		Symbol: "BTCUSDT",
+		Bids: []orderbook.PriceLevel{
+			{Price: 100, Quantity: 1},
+			{Price: 99, Quantity: 2},
+		},
+		Asks: []orderbook.PriceLevel{
+			{Price: 101, Quantity: 1},
+			{Price: 102, Quantity: 2},
+		},
+	}
+}
+
+func TestWalkBookBuyFillsAcrossLevels(t *testing.T) {
+	filledQty, notional := walkBook(testBook(), Buy, 2, 0)
+
+	if filledQty != 2 {
+		t.Fatalf("expected filledQty 2, got %v", filledQty)
+	}
+
+	wantNotional := 1*101 + 1*102
+	if notional != float64(wantNotional) {
+		t.Fatalf("expected notional %v, got %v", wantNotional, notional)
+	}
+}
+
+func TestWalkBookStopsAtPriceLimit(t *testing.T) {
+	filledQty, notional := walkBook(testBook(), Buy, 3, 101)
+
+	if filledQty != 1 {
+		t.Fatalf("expected filledQty 1 (only the first level is within the limit), got %v", filledQty)
+	}
+	if notional != 101 {
+		t.Fatalf("expected notional 101, got %v", notional)
+	}
+}
+
+func TestWalkBookSellUsesBids(t *testing.T) {
+	filledQty, notional := walkBook(testBook(), Sell, 3, 0)
+
+	if filledQty != 3 {
+		t.Fatalf("expected filledQty 3, got %v", filledQty)
+	}
+
+	wantNotional := 1*100 + 2*99
+	if notional != float64(wantNotional) {
+		t.Fatalf("expected notional %v, got %v", wantNotional, notional)
+	}
+}
+
+func TestMidPriceOfEmptyBookIsZero(t *testing.T) {
+	if got := midPrice(orderbook.OrderBook{}); got != 0 {
+		t.Fatalf("expected mid price 0 for an empty book, got %v", got)
+	}
+}
+
+func TestSlippageBpsPositiveWhenBuyFillsAboveMid(t *testing.T) {
+	got := slippageBps(Buy, 101, 100)
+	if got <= 0 {
+		t.Fatalf("expected positive slippage for a buy filled above mid, got %v", got)
+	}
+}
+
+func TestSlippageBpsPositiveWhenSellFillsBelowMid(t *testing.T) {
+	got := slippageBps(Sell, 99, 100)
+	if got <= 0 {
+		t.Fatalf("expected positive slippage for a sell filled below mid, got %v", got)
+	}
+}
@@ -25,19 +25,50 @@ type OrderBook struct {
 	Asks       []PriceLevel
 }
 
+// BookTicker represents the best bid/offer for a symbol, as delivered by an
+// exchange's top-of-book stream. It is much cheaper to consume than full
+// depth for users who only need the current spread.
+type BookTicker struct {
+	Symbol    string
+	BidPrice  float64
+	BidQty    float64
+	AskPrice  float64
+	AskQty    float64
+	UpdateID  int64
+	Timestamp time.Time
+}
+
+// MiniTicker represents a 24-hour rolling summary for a single symbol, as
+// delivered by an exchange's all-market mini-ticker stream. Unlike
+// BookTicker it is not tied to a subscribed symbol: it is one entry in a
+// batch covering every actively traded symbol.
+type MiniTicker struct {
+	Symbol      string
+	Open        float64
+	High        float64
+	Low         float64
+	Close       float64
+	Volume      float64
+	QuoteVolume float64
+	EventTime   time.Time
+}
+
 // OrderBookSource is an interface for any exchange or data source that can provide order book data
 type OrderBookSource interface {
 	// GetSymbol returns the trading pair symbol
 	GetSymbol() string
-	
+
 	// Connect establishes a connection to the exchange
 	Connect() error
-	
+
 	// Disconnect closes the connection to the exchange
 	Disconnect() error
-	
+
 	// SubscribeOrderBook subscribes to order book updates for the configured symbol
 	SubscribeOrderBook() (<-chan OrderBook, error)
+
+	// SubscribeBookTicker subscribes to best bid/offer updates for the configured symbol
+	SubscribeBookTicker() (<-chan BookTicker, error)
 }
 
 // GetTopLevels returns the top N levels of the order book
@@ -0,0 +1,57 @@
+package reconnect
+
+import (
+	"math/rand"
+	"time"
+)
+
+// BackoffConfig configures exponential-backoff-with-jitter delays between
+// reconnect attempts
+type BackoffConfig struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+}
+
+// DefaultBackoffConfig returns conservative defaults for reconnecting a
+// single WebSocket connection
+func DefaultBackoffConfig() BackoffConfig {
+	return BackoffConfig{
+		Initial: 500 * time.Millisecond,
+		Max:     30 * time.Second,
+		Factor:  2,
+	}
+}
+
+// Backoff tracks the growing delay to apply across consecutive failed
+// reconnect attempts, using full jitter to avoid thundering-herd reconnects
+type Backoff struct {
+	cfg     BackoffConfig
+	current time.Duration
+}
+
+// NewBackoff creates a Backoff starting at cfg.Initial
+func NewBackoff(cfg BackoffConfig) *Backoff {
+	return &Backoff{cfg: cfg, current: cfg.Initial}
+}
+
+// Next returns the delay to wait before the next attempt and grows the base
+// delay for the attempt after that, capped at cfg.Max
+func (b *Backoff) Next() time.Duration {
+	delay := b.current
+	if delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay)))
+	}
+
+	b.current = time.Duration(float64(b.current) * b.cfg.Factor)
+	if b.current > b.cfg.Max {
+		b.current = b.cfg.Max
+	}
+
+	return delay
+}
+
+// Reset returns the backoff to its initial delay, e.g. after a successful connection
+func (b *Backoff) Reset() {
+	b.current = b.cfg.Initial
+}
@@ -0,0 +1,89 @@
+// Package reconnect provides a reusable auto-reconnect layer with
+// exponential backoff and a circuit breaker for WebSocket-backed order book
+// providers.
+package reconnect
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitBreakerConfig configures when a CircuitBreaker trips and how long
+// it stays open before allowing reconnect attempts again
+type CircuitBreakerConfig struct {
+	// MaxConsecutiveErrors trips the breaker once this many errors in a row
+	// have been recorded without an intervening success
+	MaxConsecutiveErrors int
+	// MaxErrorsPerWindow trips the breaker once this many errors have been
+	// recorded within Window
+	MaxErrorsPerWindow int
+	// Window is the period over which MaxErrorsPerWindow is counted. It is a
+	// fixed window: the count resets at the first error recorded after Window
+	// has passed since the window began.
+	Window time.Duration
+	// Cooldown is how long the breaker stays open once tripped
+	Cooldown time.Duration
+}
+
+// DefaultCircuitBreakerConfig returns conservative defaults suitable for a
+// single symbol's WebSocket connection
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		MaxConsecutiveErrors: 5,
+		MaxErrorsPerWindow:   10,
+		Window:               time.Minute,
+		Cooldown:             30 * time.Second,
+	}
+}
+
+// CircuitBreaker pauses reconnect attempts once a provider has failed too
+// many times in a row, or too many times within one error-counting window
+type CircuitBreaker struct {
+	cfg CircuitBreakerConfig
+
+	mu           sync.Mutex
+	consecutive  int
+	windowStart  time.Time
+	windowErrors int
+	openUntil    time.Time
+}
+
+// NewCircuitBreaker creates a CircuitBreaker using cfg
+func NewCircuitBreaker(cfg CircuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{cfg: cfg, windowStart: time.Now()}
+}
+
+// RecordSuccess clears the consecutive-error counter after a successful connection
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutive = 0
+}
+
+// RecordError records a connection failure, tripping the breaker once either
+// threshold configured in CircuitBreakerConfig is reached
+func (b *CircuitBreaker) RecordError() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.consecutive++
+
+	if now.Sub(b.windowStart) > b.cfg.Window {
+		b.windowStart = now
+		b.windowErrors = 0
+	}
+	b.windowErrors++
+
+	if b.consecutive >= b.cfg.MaxConsecutiveErrors || b.windowErrors >= b.cfg.MaxErrorsPerWindow {
+		b.openUntil = now.Add(b.cfg.Cooldown)
+	}
+}
+
+// Open reports whether the breaker is currently tripped and reconnects
+// should be paused
+func (b *CircuitBreaker) Open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return time.Now().Before(b.openUntil)
+}
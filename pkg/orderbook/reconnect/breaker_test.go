@@ -0,0 +1,44 @@
+package reconnect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsOnConsecutiveErrors(t *testing.T) {
+	b := NewCircuitBreaker(CircuitBreakerConfig{
+		MaxConsecutiveErrors: 3,
+		MaxErrorsPerWindow:   100,
+		Window:               time.Minute,
+		Cooldown:             time.Hour,
+	})
+
+	for i := 0; i < 2; i++ {
+		b.RecordError()
+		if b.Open() {
+			t.Fatalf("breaker tripped too early after %d errors", i+1)
+		}
+	}
+
+	b.RecordError()
+	if !b.Open() {
+		t.Fatal("expected breaker to be open after reaching MaxConsecutiveErrors")
+	}
+}
+
+func TestCircuitBreakerRecordSuccessResetsConsecutiveCount(t *testing.T) {
+	b := NewCircuitBreaker(CircuitBreakerConfig{
+		MaxConsecutiveErrors: 2,
+		MaxErrorsPerWindow:   100,
+		Window:               time.Minute,
+		Cooldown:             time.Hour,
+	})
+
+	b.RecordError()
+	b.RecordSuccess()
+	b.RecordError()
+
+	if b.Open() {
+		t.Fatal("expected a success to reset the consecutive-error count")
+	}
+}
@@ -0,0 +1,231 @@
+package reconnect
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// ErrCircuitOpen reports that the circuit breaker has tripped and reconnect
+// attempts are paused for the cooldown period. Source logs it during
+// background reconnects; Connect does not consult the breaker.
+var ErrCircuitOpen = errors.New("reconnect: circuit breaker open")
+
+// Source wraps an orderbook.OrderBookSource factory as an OrderBookSource,
+// transparently reconnecting the order book stream on connection drops with
+// exponential backoff and pausing via a CircuitBreaker when failures
+// persist. It flushes all state on every reconnect, so subscribers see a
+// fresh snapshot from the new connection. BookTicker subscriptions are
+// forwarded to whichever underlying connection is currently active; unlike
+// SubscribeOrderBook they are not themselves reconnect-aware, so callers
+// needing a resilient BBO stream should call SubscribeBookTicker again
+// after an order book reconnect.
+type Source struct {
+	symbol      string
+	newProvider func() orderbook.OrderBookSource
+	breaker     *CircuitBreaker
+	backoffCfg  BackoffConfig
+
+	mu      sync.Mutex
+	current orderbook.OrderBookSource
+
+	closeOnce sync.Once
+	done      chan struct{}
+
+	orderbooks chan orderbook.OrderBook
+}
+
+// NewSource wraps newProvider, a factory that builds a fresh OrderBookSource
+// for each (re)connection attempt, e.g.
+//
+//	reconnect.NewSource("BTCUSDT", func() orderbook.OrderBookSource {
+//	    return binance.NewBinanceOrderBookProvider("BTCUSDT")
+//	}, reconnect.DefaultCircuitBreakerConfig())
+func NewSource(symbol string, newProvider func() orderbook.OrderBookSource, breakerCfg CircuitBreakerConfig) *Source {
+	return &Source{
+		symbol:      symbol,
+		newProvider: newProvider,
+		breaker:     NewCircuitBreaker(breakerCfg),
+		backoffCfg:  DefaultBackoffConfig(),
+		done:        make(chan struct{}),
+		orderbooks:  make(chan orderbook.OrderBook, 100),
+	}
+}
+
+// GetSymbol returns the trading pair symbol
+func (s *Source) GetSymbol() string {
+	return s.symbol
+}
+
+// Connect builds and connects the first underlying provider. Later drops are
+// reconnected transparently in the background once SubscribeOrderBook has
+// been called; only this initial attempt surfaces an error to the caller.
+func (s *Source) Connect() error {
+	provider := s.newProvider()
+	if err := provider.Connect(); err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	s.breaker.RecordSuccess()
+	s.setCurrent(provider)
+	return nil
+}
+
+// Disconnect stops background reconnect attempts and disconnects the
+// currently active provider
+func (s *Source) Disconnect() error {
+	s.closeOnce.Do(func() { close(s.done) })
+
+	if provider := s.currentProvider(); provider != nil {
+		return provider.Disconnect()
+	}
+	return nil
+}
+
+// SubscribeOrderBook subscribes to order book updates on the connection
+// established by Connect, and keeps them flowing across reconnects until
+// Disconnect is called
+func (s *Source) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	provider := s.currentProvider()
+	if provider == nil {
+		return nil, errors.New("reconnect: Connect must be called before SubscribeOrderBook")
+	}
+
+	updates, err := provider.SubscribeOrderBook()
+	if err != nil {
+		return nil, fmt.Errorf("subscribe: %w", err)
+	}
+
+	go s.run(updates)
+	return s.orderbooks, nil
+}
+
+// SubscribeBookTicker forwards to the currently active underlying connection
+func (s *Source) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	provider := s.currentProvider()
+	if provider == nil {
+		return nil, errors.New("reconnect: Connect must be called before SubscribeBookTicker")
+	}
+	return provider.SubscribeBookTicker()
+}
+
+// run relays updates to s.orderbooks and, whenever the underlying connection
+// drops, reconnects with exponential backoff and a circuit breaker until a
+// fresh stream is flowing again or Disconnect is called
+func (s *Source) run(updates <-chan orderbook.OrderBook) {
+	defer close(s.orderbooks)
+
+	for {
+		if !s.relay(updates) {
+			return
+		}
+
+		next, ok := s.reconnectLoop()
+		if !ok {
+			return
+		}
+		updates = next
+	}
+}
+
+// relay forwards order books from updates to s.orderbooks until updates
+// closes (reporting true, so the caller should reconnect) or Disconnect is
+// called (reporting false)
+func (s *Source) relay(updates <-chan orderbook.OrderBook) bool {
+	for {
+		select {
+		case <-s.done:
+			return false
+		case ob, ok := <-updates:
+			if !ok {
+				return true
+			}
+			select {
+			case s.orderbooks <- ob:
+			default:
+				// Slow subscriber; drop rather than block the reconnect loop
+			}
+		}
+	}
+}
+
+// reconnectLoop retries connecting a fresh provider with exponential backoff,
+// pausing via the circuit breaker when failures persist, until it succeeds
+// or Disconnect is called
+func (s *Source) reconnectLoop() (<-chan orderbook.OrderBook, bool) {
+	backoff := NewBackoff(s.backoffCfg)
+
+	for {
+		select {
+		case <-s.done:
+			return nil, false
+		default:
+		}
+
+		if s.breaker.Open() {
+			slog.Warn("reconnect: circuit open, pausing order book reconnects", "symbol", s.symbol, "error", ErrCircuitOpen)
+			if !s.sleep(s.backoffCfg.Initial) {
+				return nil, false
+			}
+			continue
+		}
+
+		updates, err := s.reconnect()
+		if err != nil {
+			s.breaker.RecordError()
+			slog.Warn("reconnect: failed to reconnect order book stream", "symbol", s.symbol, "error", err)
+			if !s.sleep(backoff.Next()) {
+				return nil, false
+			}
+			continue
+		}
+
+		s.breaker.RecordSuccess()
+		return updates, true
+	}
+}
+
+// reconnect builds and connects a fresh provider, replacing the one
+// currently active, and returns its order book update channel
+func (s *Source) reconnect() (<-chan orderbook.OrderBook, error) {
+	provider := s.newProvider()
+	if err := provider.Connect(); err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+
+	updates, err := provider.SubscribeOrderBook()
+	if err != nil {
+		provider.Disconnect()
+		return nil, fmt.Errorf("subscribe: %w", err)
+	}
+
+	s.setCurrent(provider)
+	return updates, nil
+}
+
+// sleep waits for d or until Disconnect is called, reporting whether it
+// slept the full duration (false means Disconnect interrupted it)
+func (s *Source) sleep(d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+func (s *Source) setCurrent(provider orderbook.OrderBookSource) {
+	s.mu.Lock()
+	s.current = provider
+	s.mu.Unlock()
+}
+
+func (s *Source) currentProvider() orderbook.OrderBookSource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.current
+}
@@ -0,0 +1,82 @@
+// Package record persists a live order book stream to a length-prefixed,
+// gzip-compressed binary log, and replays such a log back as an
+// orderbook.OrderBookSource, so downstream renderers and execution
+// simulators can be backtested deterministically against captured data.
+package record
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// formatVersion identifies the binary log layout written by Recorder and
+// understood by ReplaySource
+const formatVersion = 1
+
+// header is the schema header written once at the start of every log
+type header struct {
+	Version   int       `json:"version"`
+	Symbol    string    `json:"symbol"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// entry is one record in the log: an order book snapshot stamped with its
+// offset from the recording's start, so replay can preserve inter-event timing
+type entry struct {
+	OffsetMillis int64               `json:"offset_ms"`
+	OrderBook    orderbook.OrderBook `json:"order_book"`
+}
+
+// writeFrame gzip-compresses v's JSON encoding and writes it as a
+// [uint32 length][gzipped bytes] frame
+func writeFrame(w io.Writer, v any) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(payload); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(compressed.Len()))
+	if _, err := w.Write(length[:]); err != nil {
+		return err
+	}
+	_, err = w.Write(compressed.Bytes())
+	return err
+}
+
+// readFrame reads a [uint32 length][gzipped bytes] frame and JSON-decodes
+// its payload into v. It returns io.EOF once the log is exhausted.
+func readFrame(r io.Reader, v any) error {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return err
+	}
+
+	compressed := make([]byte, binary.BigEndian.Uint32(length[:]))
+	if _, err := io.ReadFull(r, compressed); err != nil {
+		return err
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	return json.NewDecoder(gz).Decode(v)
+}
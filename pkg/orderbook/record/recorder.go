@@ -0,0 +1,56 @@
+package record
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// Recorder serializes a live stream of order book updates to a
+// length-prefixed, gzip-compressed binary log on disk for later replay via ReplaySource.
+type Recorder struct {
+	f         *os.File
+	w         *bufio.Writer
+	startedAt time.Time
+}
+
+// NewRecorder creates path, writes its schema header, and returns a
+// Recorder ready to accept updates via Write
+func NewRecorder(path string, symbol string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("record: create %s: %w", path, err)
+	}
+
+	startedAt := time.Now().UTC()
+	w := bufio.NewWriter(f)
+
+	h := header{Version: formatVersion, Symbol: symbol, StartedAt: startedAt}
+	if err := writeFrame(w, h); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("record: write header: %w", err)
+	}
+
+	return &Recorder{f: f, w: w, startedAt: startedAt}, nil
+}
+
+// Write appends ob to the log, stamped with its offset from the recording's start
+func (r *Recorder) Write(ob orderbook.OrderBook) error {
+	e := entry{
+		OffsetMillis: time.Since(r.startedAt).Milliseconds(),
+		OrderBook:    ob,
+	}
+	return writeFrame(r.w, e)
+}
+
+// Close flushes buffered data and closes the underlying file
+func (r *Recorder) Close() error {
+	if err := r.w.Flush(); err != nil {
+		r.f.Close()
+		return fmt.Errorf("record: flush: %w", err)
+	}
+	return r.f.Close()
+}
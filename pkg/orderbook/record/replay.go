@@ -0,0 +1,119 @@
+package record
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+// ReplaySource implements orderbook.OrderBookSource by reading a log
+// written by Recorder and re-emitting its order books preserving
+// inter-event timing, scaled by the speed given to NewReplaySource.
+type ReplaySource struct {
+	path   string
+	speed  float64
+	symbol string
+
+	f    *os.File
+	done chan struct{}
+	obs  chan orderbook.OrderBook
+}
+
+// NewReplaySource creates a ReplaySource that reads path when Connect is
+// called. speed scales inter-event delays: 2 replays twice as fast, 0.5
+// replays at half speed, and a speed <= 0 replays every record back-to-back
+// with no delay.
+func NewReplaySource(path string, speed float64) *ReplaySource {
+	return &ReplaySource{
+		path:  path,
+		speed: speed,
+		done:  make(chan struct{}),
+		obs:   make(chan orderbook.OrderBook, 100),
+	}
+}
+
+// GetSymbol returns the symbol recorded in the log's header
+func (s *ReplaySource) GetSymbol() string {
+	return s.symbol
+}
+
+// Connect opens the log file and reads its schema header
+func (s *ReplaySource) Connect() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("record: open %s: %w", s.path, err)
+	}
+
+	var h header
+	if err := readFrame(f, &h); err != nil {
+		f.Close()
+		return fmt.Errorf("record: read header: %w", err)
+	}
+	if h.Version != formatVersion {
+		f.Close()
+		return fmt.Errorf("record: unsupported log version %d", h.Version)
+	}
+
+	s.symbol = h.Symbol
+	s.f = f
+	return nil
+}
+
+// Disconnect stops the replay and closes the log file
+func (s *ReplaySource) Disconnect() error {
+	close(s.done)
+	if s.f != nil {
+		return s.f.Close()
+	}
+	return nil
+}
+
+// SubscribeOrderBook starts replaying the log and returns a channel of the
+// order books it contains, closed once the log is exhausted
+func (s *ReplaySource) SubscribeOrderBook() (<-chan orderbook.OrderBook, error) {
+	go s.replay()
+	return s.obs, nil
+}
+
+// SubscribeBookTicker is unsupported: a recorded log only captures full
+// order book snapshots, never top-of-book-only updates
+func (s *ReplaySource) SubscribeBookTicker() (<-chan orderbook.BookTicker, error) {
+	return nil, fmt.Errorf("record: ReplaySource does not support book ticker streams")
+}
+
+// replay reads entries from the log in order, sleeping between them to
+// preserve the recorded inter-event timing (scaled by s.speed), and
+// publishes each order book to s.obs
+func (s *ReplaySource) replay() {
+	defer close(s.obs)
+
+	var lastOffset time.Duration
+	for {
+		var e entry
+		if err := readFrame(s.f, &e); err != nil {
+			if err != io.EOF {
+				fmt.Printf("record: read frame: %v\n", err)
+			}
+			return
+		}
+
+		offset := time.Duration(e.OffsetMillis) * time.Millisecond
+		if delay := offset - lastOffset; delay > 0 && s.speed > 0 {
+			select {
+			case <-time.After(time.Duration(float64(delay) / s.speed)):
+			case <-s.done:
+				return
+			}
+		}
+		lastOffset = offset
+
+		select {
+		case s.obs <- e.OrderBook:
+		case <-s.done:
+			return
+		}
+	}
+}
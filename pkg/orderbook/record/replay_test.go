@@ -0,0 +1,98 @@
+package record
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/VladKochetov007/lob_view/pkg/orderbook"
+)
+
+func TestRecorderReplaySourceRoundTrip(t *testing.T) {
+	path := t.TempDir() + "/test.log"
+
+	recorder, err := NewRecorder(path, "BTCUSDT")
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+
+	want := []orderbook.OrderBook{
+		{
+			Symbol: "BTCUSDT",
+			Bids:   []orderbook.PriceLevel{{Price: 100, Quantity: 1}},
+			Asks:   []orderbook.PriceLevel{{Price: 101, Quantity: 1}},
+		},
+		{
+			Symbol: "BTCUSDT",
+			Bids:   []orderbook.PriceLevel{{Price: 100.5, Quantity: 2}},
+			Asks:   []orderbook.PriceLevel{{Price: 101.5, Quantity: 2}},
+		},
+	}
+
+	for _, ob := range want {
+		if err := recorder.Write(ob); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	source := NewReplaySource(path, 0) // as-fast-as-possible
+	if err := source.Connect(); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	defer source.Disconnect()
+
+	if got := source.GetSymbol(); got != "BTCUSDT" {
+		t.Fatalf("expected symbol BTCUSDT, got %q", got)
+	}
+
+	updates, err := source.SubscribeOrderBook()
+	if err != nil {
+		t.Fatalf("SubscribeOrderBook: %v", err)
+	}
+
+	for i, wantOb := range want {
+		select {
+		case got, ok := <-updates:
+			if !ok {
+				t.Fatalf("channel closed early at index %d", i)
+			}
+			if got.Symbol != wantOb.Symbol || len(got.Bids) != len(wantOb.Bids) || len(got.Asks) != len(wantOb.Asks) {
+				t.Fatalf("record %d mismatch: got %+v, want %+v", i, got, wantOb)
+			}
+			if got.Bids[0].Price != wantOb.Bids[0].Price || got.Asks[0].Price != wantOb.Asks[0].Price {
+				t.Fatalf("record %d price mismatch: got %+v, want %+v", i, got, wantOb)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for record %d", i)
+		}
+	}
+
+	select {
+	case _, ok := <-updates:
+		if ok {
+			t.Fatal("expected channel to be closed after all records replayed")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for channel close")
+	}
+}
+
+func TestReplaySourceRejectsUnsupportedVersion(t *testing.T) {
+	path := t.TempDir() + "/bad.log"
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := writeFrame(f, header{Version: formatVersion + 1, Symbol: "X"}); err != nil {
+		t.Fatalf("writeFrame: %v", err)
+	}
+	f.Close()
+
+	source := NewReplaySource(path, 1)
+	if err := source.Connect(); err == nil {
+		t.Fatal("expected Connect to reject an unsupported log version")
+	}
+}